@@ -144,16 +144,10 @@ func printTrace(trace *langfuse.TraceWithFullDetails) {
 		fmt.Println()
 
 		// Time information
-		fmt.Printf("    │  Time: %s", obs.StartTime)
+		fmt.Printf("    │  Time: %s", obs.StartTime.Format(time.RFC3339Nano))
 		if obs.EndTime != nil {
-			fmt.Printf(" → %s", *obs.EndTime)
-			// Calculate duration
-			if startTime, err := time.Parse(time.RFC3339Nano, obs.StartTime); err == nil {
-				if endTime, err := time.Parse(time.RFC3339Nano, *obs.EndTime); err == nil {
-					duration := endTime.Sub(startTime)
-					fmt.Printf(" (duration: %v)", duration.Round(time.Millisecond))
-				}
-			}
+			fmt.Printf(" → %s", obs.EndTime.Format(time.RFC3339Nano))
+			fmt.Printf(" (duration: %v)", obs.Duration().Round(time.Millisecond))
 		}
 		fmt.Println()
 
@@ -173,7 +167,7 @@ func printTrace(trace *langfuse.TraceWithFullDetails) {
 		}
 
 		// Model & Usage (for GENERATION type)
-		if obs.Type == "GENERATION" {
+		if obs.Type == langfuse.ObservationTypeGeneration {
 			if obs.Model != nil {
 				fmt.Printf("    │  Model: %s\n", *obs.Model)
 			}
@@ -48,11 +48,8 @@ func main() {
 	// - 找到第一条 generation 记录
 	// - 拼接 input + output，得到上下文
 	var firstGeneration *langfuse.ObservationDetails
-	for i := 0; i < len(trace.Observations); i++ {
-		if trace.Observations[i].Type == "GENERATION" {
-			firstGeneration = &trace.Observations[i]
-			break
-		}
+	if generations := trace.Tree().Filter(langfuse.ObservationTypeGeneration); len(generations) > 0 {
+		firstGeneration = generations[0].Observation
 	}
 
 	if firstGeneration == nil {
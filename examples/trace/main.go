@@ -9,6 +9,7 @@ import (
 	"time"
 
 	langfuse "github.com/lvow2022/langfuse-gosdk/langfuse"
+	lfopenai "github.com/lvow2022/langfuse-gosdk/langfuse/openai"
 	openai "github.com/sashabaranov/go-openai"
 )
 
@@ -235,7 +236,8 @@ func main() {
 				},
 				EndTime: &finalEndTime,
 			},
-			Usage: &usage,
+			Usage:        &usage,
+			UsageDetails: lfopenai.UsageDetails(resp.Usage).Add(lfopenai.UsageDetails(finalResp.Usage)),
 		})
 
 		// Update trace with output and session metadata
@@ -0,0 +1,304 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// AnnotationQueueObjectType represents the kind of object in an annotation queue
+type AnnotationQueueObjectType string
+
+const (
+	AnnotationQueueObjectTrace       AnnotationQueueObjectType = "TRACE"
+	AnnotationQueueObjectObservation AnnotationQueueObjectType = "OBSERVATION"
+	AnnotationQueueObjectSession     AnnotationQueueObjectType = "SESSION"
+)
+
+// AnnotationQueueStatus represents the review status of a queue item
+type AnnotationQueueStatus string
+
+const (
+	AnnotationQueueStatusPending   AnnotationQueueStatus = "PENDING"
+	AnnotationQueueStatusCompleted AnnotationQueueStatus = "COMPLETED"
+)
+
+// AnnotationQueue represents an annotation queue retrieved from API
+type AnnotationQueue struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Description    *string  `json:"description,omitempty"`
+	ScoreConfigIDs []string `json:"scoreConfigIds"`
+	CreatedAt      string   `json:"createdAt"`
+	UpdatedAt      string   `json:"updatedAt"`
+}
+
+// AnnotationQueueItem represents an object waiting for or done with review
+type AnnotationQueueItem struct {
+	ID          string                    `json:"id"`
+	QueueID     string                    `json:"queueId"`
+	ObjectID    string                    `json:"objectId"`
+	ObjectType  AnnotationQueueObjectType `json:"objectType"`
+	Status      AnnotationQueueStatus     `json:"status"`
+	CompletedAt *string                   `json:"completedAt,omitempty"`
+	CreatedAt   string                    `json:"createdAt"`
+	UpdatedAt   string                    `json:"updatedAt"`
+}
+
+// PaginatedAnnotationQueues represents paginated annotation queue list response
+type PaginatedAnnotationQueues struct {
+	Data []AnnotationQueue `json:"data"`
+	Meta PaginationMeta    `json:"meta"`
+}
+
+// PaginatedAnnotationQueueItems represents paginated annotation queue item list response
+type PaginatedAnnotationQueueItems struct {
+	Data []AnnotationQueueItem `json:"data"`
+	Meta PaginationMeta        `json:"meta"`
+}
+
+// ListAnnotationQueuesParams represents parameters for listing annotation queues
+type ListAnnotationQueuesParams struct {
+	Page  *int
+	Limit *int
+}
+
+// GetAnnotationQueueParams represents parameters for fetching an annotation queue
+type GetAnnotationQueueParams struct {
+	QueueID string
+}
+
+// ListAnnotationQueueItemsParams represents parameters for listing the items of a queue
+type ListAnnotationQueueItemsParams struct {
+	QueueID string
+	Status  *AnnotationQueueStatus
+	Page    *int
+	Limit   *int
+}
+
+// AddAnnotationQueueItemParams represents parameters for adding an object to a queue
+type AddAnnotationQueueItemParams struct {
+	QueueID    string                    `json:"-"`
+	ObjectID   string                    `json:"objectId"`
+	ObjectType AnnotationQueueObjectType `json:"objectType"`
+	Status     *AnnotationQueueStatus    `json:"status,omitempty"`
+}
+
+// UpdateAnnotationQueueItemParams represents parameters for updating a queue item
+type UpdateAnnotationQueueItemParams struct {
+	QueueID string                 `json:"-"`
+	ItemID  string                 `json:"-"`
+	Status  *AnnotationQueueStatus `json:"status,omitempty"`
+}
+
+// QueueTracesByScoreParams represents parameters for routing scored traces to a queue
+type QueueTracesByScoreParams struct {
+	// QueueID is the annotation queue the traces are added to (required)
+	QueueID string
+
+	// Scores selects the scores whose traces are queued, typically with a
+	// Name, Operator and Value such as "relevance" "<" 0.5
+	Scores ListScoresParams
+
+	// MaxItems caps the number of scores inspected (default: 0, unlimited)
+	MaxItems int
+}
+
+// QueueTracesByScoreResult reports the outcome of QueueTracesByScore
+type QueueTracesByScoreResult struct {
+	// Added are the queue items created for newly queued traces
+	Added []AnnotationQueueItem
+
+	// Skipped is the number of traces that were already in the queue
+	Skipped int
+}
+
+// ListAnnotationQueues retrieves a paginated list of annotation queues
+func (c *Client) ListAnnotationQueues(ctx context.Context, params ListAnnotationQueuesParams) (*PaginatedAnnotationQueues, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/annotation-queues", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	queues, err := c.fetchJSON(ctx, fullURL, &PaginatedAnnotationQueues{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list annotation queues: %w", err)
+	}
+
+	return queues.(*PaginatedAnnotationQueues), nil
+}
+
+// GetAnnotationQueue retrieves an annotation queue by ID
+func (c *Client) GetAnnotationQueue(ctx context.Context, params GetAnnotationQueueParams) (*AnnotationQueue, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.QueueID == "" {
+		return nil, fmt.Errorf("queueID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/annotation-queues/%s", c.config.BaseURL, url.PathEscape(params.QueueID))
+
+	queue, err := c.fetchJSON(ctx, endpoint, &AnnotationQueue{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get annotation queue: %w", err)
+	}
+
+	return queue.(*AnnotationQueue), nil
+}
+
+// ListAnnotationQueueItems retrieves a paginated list of the items of a queue
+func (c *Client) ListAnnotationQueueItems(ctx context.Context, params ListAnnotationQueueItemsParams) (*PaginatedAnnotationQueueItems, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.QueueID == "" {
+		return nil, fmt.Errorf("queueID is required")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/annotation-queues/%s/items", c.config.BaseURL, url.PathEscape(params.QueueID))
+	queryParams := url.Values{}
+
+	if params.Status != nil {
+		queryParams.Set("status", string(*params.Status))
+	}
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	items, err := c.fetchJSON(ctx, fullURL, &PaginatedAnnotationQueueItems{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list annotation queue items: %w", err)
+	}
+
+	return items.(*PaginatedAnnotationQueueItems), nil
+}
+
+// AddAnnotationQueueItem adds a trace, observation or session to a queue
+func (c *Client) AddAnnotationQueueItem(ctx context.Context, params AddAnnotationQueueItemParams) (*AnnotationQueueItem, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.QueueID == "" {
+		return nil, fmt.Errorf("queueID is required")
+	}
+	if params.ObjectID == "" || params.ObjectType == "" {
+		return nil, fmt.Errorf("objectID and objectType are required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/annotation-queues/%s/items", c.config.BaseURL, url.PathEscape(params.QueueID))
+
+	item, err := c.sendJSON(ctx, http.MethodPost, endpoint, params, &AnnotationQueueItem{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add annotation queue item: %w", err)
+	}
+
+	return item.(*AnnotationQueueItem), nil
+}
+
+// UpdateAnnotationQueueItem updates a queue item, e.g. its status
+func (c *Client) UpdateAnnotationQueueItem(ctx context.Context, params UpdateAnnotationQueueItemParams) (*AnnotationQueueItem, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.QueueID == "" || params.ItemID == "" {
+		return nil, fmt.Errorf("queueID and itemID are required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/annotation-queues/%s/items/%s",
+		c.config.BaseURL, url.PathEscape(params.QueueID), url.PathEscape(params.ItemID))
+
+	item, err := c.sendJSON(ctx, http.MethodPatch, endpoint, params, &AnnotationQueueItem{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update annotation queue item: %w", err)
+	}
+
+	return item.(*AnnotationQueueItem), nil
+}
+
+// CompleteAnnotationQueueItem marks a queue item as completed
+func (c *Client) CompleteAnnotationQueueItem(ctx context.Context, queueID, itemID string) (*AnnotationQueueItem, error) {
+	return c.UpdateAnnotationQueueItem(ctx, UpdateAnnotationQueueItemParams{
+		QueueID: queueID,
+		ItemID:  itemID,
+		Status:  Ptr(AnnotationQueueStatusCompleted),
+	})
+}
+
+// QueueTracesByScore adds the traces of all scores matching params.Scores to
+// an annotation queue, e.g. to route low-scoring traces into human review.
+// Each trace is added at most once, and traces already in the queue are
+// skipped so the routing can be re-run with the same filter.
+func (c *Client) QueueTracesByScore(ctx context.Context, params QueueTracesByScoreParams) (*QueueTracesByScoreResult, error) {
+	if params.QueueID == "" {
+		return nil, fmt.Errorf("queueID is required")
+	}
+
+	result := &QueueTracesByScoreResult{}
+
+	queued := make(map[string]bool)
+	for item, err := range c.IterAnnotationQueueItems(ctx, ListAnnotationQueueItemsParams{QueueID: params.QueueID}, IterOptions{}) {
+		if err != nil {
+			return result, err
+		}
+		if item.ObjectType == AnnotationQueueObjectTrace {
+			queued[item.ObjectID] = true
+		}
+	}
+
+	seen := make(map[string]bool)
+	for score, err := range c.IterScores(ctx, params.Scores, IterOptions{MaxItems: params.MaxItems}) {
+		if err != nil {
+			return result, err
+		}
+		if score.TraceID == "" || seen[score.TraceID] {
+			continue
+		}
+		seen[score.TraceID] = true
+
+		if queued[score.TraceID] {
+			result.Skipped++
+			continue
+		}
+
+		item, err := c.AddAnnotationQueueItem(ctx, AddAnnotationQueueItemParams{
+			QueueID:    params.QueueID,
+			ObjectID:   score.TraceID,
+			ObjectType: AnnotationQueueObjectTrace,
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Added = append(result.Added, *item)
+	}
+
+	return result, nil
+}
@@ -2,7 +2,7 @@ package langfuse
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"sync"
 	"time"
 )
@@ -40,9 +40,7 @@ func (b *Batcher) Start() {
 			select {
 			case <-b.ticker.C:
 				if err := b.Flush(context.Background()); err != nil {
-					if b.config.Debug {
-						log.Printf("[Langfuse] Error flushing events: %v", err)
-					}
+					b.client.logger.Debug("error flushing events", slog.Any("error", err))
 				}
 			case <-b.done:
 				b.ticker.Stop()
@@ -64,9 +62,11 @@ func (b *Batcher) Add(event Event) error {
 
 	// Check if queue is full
 	if len(b.queue) >= b.config.MaxQueueSize {
-		if b.config.Debug {
-			log.Printf("[Langfuse] Queue is full (%d events), dropping event", len(b.queue))
-		}
+		b.client.logger.Warn("queue is full, dropping event",
+			slog.Int("queue_size", len(b.queue)),
+			slog.String("event_id", event.ID),
+			slog.String("event_type", string(event.Type)),
+		)
 
 		// Record dropped event
 		if b.config.MetricsEnabled {
@@ -82,15 +82,16 @@ func (b *Batcher) Add(event Event) error {
 	}
 
 	b.queue = append(b.queue, event)
+	if b.config.MetricsEnabled {
+		b.client.metrics.RecordQueueDepth(len(b.queue))
+	}
 
 	// Auto-flush if we've reached FlushAt threshold
 	if len(b.queue) >= b.config.FlushAt {
 		// Unlock before flushing to avoid deadlock
 		b.mu.Unlock()
 		if err := b.Flush(context.Background()); err != nil {
-			if b.config.Debug {
-				log.Printf("[Langfuse] Error auto-flushing: %v", err)
-			}
+			b.client.logger.Debug("error auto-flushing events", slog.Any("error", err))
 		}
 		b.mu.Lock()
 	}
@@ -118,12 +119,17 @@ func (b *Batcher) Flush(ctx context.Context) error {
 	req := &IngestionRequest{
 		Batch: events,
 	}
+	logger := b.client.logger.With(slog.String("batch_id", generateID()))
 
+	start := time.Now()
 	resp, err := b.client.sendIngestion(ctx, req)
+	if b.config.MetricsEnabled {
+		b.client.metrics.RecordBatch(len(events))
+	}
 
 	// Handle errors
 	if err != nil {
-		b.handleFlushError(events, err, resp)
+		b.handleFlushError(logger, events, err, resp)
 		return err
 	}
 
@@ -145,22 +151,36 @@ func (b *Batcher) Flush(ctx context.Context) error {
 	}
 
 	// Log any errors from the API
-	if resp != nil && len(resp.Errors) > 0 {
-		if b.config.Debug {
-			log.Printf("[Langfuse] API returned %d errors out of %d events", len(resp.Errors), len(events))
-		}
+	if errorCount > 0 {
+		logger.Warn("ingestion API rejected events",
+			slog.Int("events", len(events)),
+			slog.Int("errors", errorCount),
+			slog.Duration("latency", time.Since(start)),
+		)
+	} else {
+		logger.Debug("flushed events",
+			slog.Int("events", len(events)),
+			slog.Int("successes", successCount),
+			slog.Duration("latency", time.Since(start)),
+		)
 	}
 
 	return nil
 }
 
 // handleFlushError processes errors during flush
-func (b *Batcher) handleFlushError(events []Event, err error, resp *IngestionResponse) {
+func (b *Batcher) handleFlushError(logger *slog.Logger, events []Event, err error, resp *IngestionResponse) {
+	if b.config.MetricsEnabled {
+		b.client.metrics.RecordFailure()
+	}
+
 	// Check if this is a retryable error
 	if langfuseErr, ok := err.(*LangfuseError); ok && langfuseErr.IsRetryable() {
-		if b.config.Debug {
-			log.Printf("[Langfuse] Retryable error encountered: %v", err)
-		}
+		logger.Warn("retryable error flushing events, requeueing",
+			slog.Int("events", len(events)),
+			slog.Int("status", langfuseErr.StatusCode),
+			slog.Any("error", err),
+		)
 
 		// Record retry attempt
 		if b.config.MetricsEnabled {
@@ -175,9 +195,10 @@ func (b *Batcher) handleFlushError(events []Event, err error, resp *IngestionRes
 	}
 
 	// Non-retryable error - record and discard
-	if b.config.Debug {
-		log.Printf("[Langfuse] Non-retryable error, dropping %d events: %v", len(events), err)
-	}
+	logger.Error("non-retryable error flushing events, dropping them",
+		slog.Int("events", len(events)),
+		slog.Any("error", err),
+	)
 
 	// Record failed events for monitoring
 	if b.config.MetricsEnabled {
@@ -187,6 +208,13 @@ func (b *Batcher) handleFlushError(events []Event, err error, resp *IngestionRes
 	}
 }
 
+// Len returns the number of queued events
+func (b *Batcher) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.queue)
+}
+
 // Close stops the batcher and flushes remaining events
 func (b *Batcher) Close(ctx context.Context) error {
 	close(b.done)
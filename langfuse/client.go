@@ -7,8 +7,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
@@ -21,8 +22,33 @@ type Client struct {
 	httpClient *http.Client
 	batcher    *Batcher
 	metrics    *Metrics
+	logger     *slog.Logger
 	mu         sync.Mutex
 	closed     bool
+
+	// inflight counts enqueue calls that passed the closed check but have
+	// not yet added their events to the batcher
+	inflight sync.WaitGroup
+
+	// sampling applies Config.Sampler decisions to all events of a trace
+	sampling *traceSampler
+
+	// media uploads attachments when Config.UploadMedia is set
+	media *mediaUploader
+
+	// scoreConfigs caches score config lookups by ID for client-side validation
+	scoreConfigMu sync.RWMutex
+	scoreConfigs  map[string]scoreConfigEntry
+
+	// generationModels remembers the models of generations so that updates
+	// without a model can still be priced by Config.CostCalculator
+	generationModelMu    sync.Mutex
+	generationModels     map[string]string
+	generationModelOrder []string
+
+	// cachedProjectID is the project the API keys belong to, resolved lazily
+	projectMu       sync.Mutex
+	cachedProjectID string
 }
 
 // NewClient creates a new Langfuse client with the given configuration
@@ -41,17 +67,38 @@ func NewClient(config *Config) (*Client, error) {
 			Timeout: config.Timeout,
 		},
 		metrics: &Metrics{},
+		logger:  newLogger(config),
 	}
 
 	// Initialize batcher for async event sending
 	if config.Enabled {
 		client.batcher = NewBatcher(client, config)
 		client.batcher.Start()
+
+		if config.Sampler != nil {
+			client.sampling = newTraceSampler(config)
+		}
+
+		if config.UploadMedia {
+			client.media = newMediaUploader(client)
+		}
 	}
 
 	return client, nil
 }
 
+// newLogger returns the logger configured for the client
+func newLogger(config *Config) *slog.Logger {
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.Default()
+		if config.Debug {
+			logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		}
+	}
+	return logger.With(slog.String(sdkLogAttr, "langfuse"))
+}
+
 // makeAuthHeader creates the Basic Auth header
 func (c *Client) makeAuthHeader() string {
 	auth := c.config.PublicKey + ":" + c.config.SecretKey
@@ -84,11 +131,16 @@ func (c *Client) sendIngestion(ctx context.Context, req *IngestionRequest) (*Ing
 		httpReq.Header.Set("X-Langfuse-Sdk-Integration", c.config.SDKIntegration)
 	}
 
-	if c.config.Debug {
-		log.Printf("[Langfuse] Sending %d events to %s", len(req.Batch), url)
-	}
+	c.logger.Debug("sending ingestion request", slog.Int("events", len(req.Batch)), slog.String("url", url))
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(httpReq)
+	latency := time.Since(start)
+	if c.config.MetricsEnabled {
+		// The same measurement feeds the request window and the flush histogram
+		c.metrics.RecordRequest(latency, len(body))
+		c.metrics.RecordFlushLatency(latency)
+	}
 	if err != nil {
 		return nil, NewNetworkError(err)
 	}
@@ -111,13 +163,19 @@ func (c *Client) sendIngestion(ctx context.Context, req *IngestionRequest) (*Ing
 		}
 	}
 
-	if c.config.Debug {
-		log.Printf("[Langfuse] Response: %d successes, %d errors", len(ingestionResp.Successes), len(ingestionResp.Errors))
-		if len(ingestionResp.Errors) > 0 {
-			for _, e := range ingestionResp.Errors {
-				log.Printf("[Langfuse] Error: %s - %s", e.Error, e.Message)
-			}
-		}
+	c.logger.Debug("ingestion response",
+		slog.Int("status", resp.StatusCode),
+		slog.Int("successes", len(ingestionResp.Successes)),
+		slog.Int("errors", len(ingestionResp.Errors)),
+		slog.Duration("latency", latency),
+	)
+	for _, e := range ingestionResp.Errors {
+		c.logger.Warn("event rejected by ingestion API",
+			slog.String("event_id", e.ID),
+			slog.Int("status", e.Status),
+			slog.String("error", e.Error),
+			slog.String("message", e.Message),
+		)
 	}
 
 	return &ingestionResp, nil
@@ -126,16 +184,53 @@ func (c *Client) sendIngestion(ctx context.Context, req *IngestionRequest) (*Ing
 // enqueue adds an event to the batch queue
 func (c *Client) enqueue(event Event) error {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 
 	if c.closed {
+		c.mu.Unlock()
 		return fmt.Errorf("client is closed")
 	}
 
 	if !c.config.Enabled {
+		c.mu.Unlock()
 		return nil
 	}
 
+	events := []Event{event}
+	if c.sampling != nil {
+		events = c.sampling.filter(event)
+	}
+
+	// Preparing events can be slow for large payloads, so it runs without the
+	// lock; Close waits for in-flight events before flushing
+	c.inflight.Add(1)
+	c.mu.Unlock()
+	defer c.inflight.Done()
+
+	for _, e := range events {
+		if err := c.add(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add prepares an event that passed sampling and adds it to the batcher
+func (c *Client) add(event Event) error {
+	if c.config.Mask != nil {
+		if masked := maskEvent(c.config.Mask, &event); masked > 0 && c.config.MetricsEnabled {
+			c.metrics.RecordMasked(masked)
+		}
+	}
+
+	if c.media != nil {
+		c.media.processEvent(&event)
+	}
+
+	// Truncation comes last so masking and media upload see complete values
+	if c.config.Truncation != nil {
+		truncateEvent(c.config.Truncation, &event)
+	}
+
 	return c.batcher.Add(event)
 }
 
@@ -162,6 +257,13 @@ func (c *Client) Close() error {
 	c.closed = true
 	c.mu.Unlock()
 
+	c.inflight.Wait()
+
+	// Finish pending uploads so media references resolve once events arrive
+	if c.media != nil {
+		c.media.close()
+	}
+
 	if c.batcher != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -173,7 +275,11 @@ func (c *Client) Close() error {
 
 // GetMetrics returns a snapshot of current metrics
 func (c *Client) GetMetrics() MetricsSnapshot {
-	return c.metrics.GetSnapshot()
+	snapshot := c.metrics.GetSnapshot()
+	if c.batcher != nil {
+		snapshot.QueueDepth = c.batcher.Len()
+	}
+	return snapshot
 }
 
 // GetFailedEvents returns a copy of the failed events list
@@ -0,0 +1,231 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CommentObjectType represents the kind of object a comment is attached to
+type CommentObjectType string
+
+const (
+	CommentObjectTrace       CommentObjectType = "TRACE"
+	CommentObjectObservation CommentObjectType = "OBSERVATION"
+	CommentObjectSession     CommentObjectType = "SESSION"
+	CommentObjectPrompt      CommentObjectType = "PROMPT"
+)
+
+// Comment represents a comment retrieved from API
+type Comment struct {
+	ID           string            `json:"id"`
+	ProjectID    string            `json:"projectId"`
+	ObjectType   CommentObjectType `json:"objectType"`
+	ObjectID     string            `json:"objectId"`
+	Content      string            `json:"content"`
+	AuthorUserID *string           `json:"authorUserId,omitempty"`
+	CreatedAt    string            `json:"createdAt"`
+	UpdatedAt    string            `json:"updatedAt"`
+}
+
+// PaginatedComments represents paginated comment list response
+type PaginatedComments struct {
+	Data []Comment      `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// CreateCommentParams represents parameters for creating a comment
+type CreateCommentParams struct {
+	// ProjectID is resolved from the API keys if empty
+	ProjectID    string            `json:"projectId"`
+	ObjectType   CommentObjectType `json:"objectType"`
+	ObjectID     string            `json:"objectId"`
+	Content      string            `json:"content"`
+	AuthorUserID *string           `json:"authorUserId,omitempty"`
+}
+
+// CreateCommentResponse represents the response of creating a comment
+type CreateCommentResponse struct {
+	ID string `json:"id"`
+}
+
+// GetCommentParams represents parameters for fetching a comment
+type GetCommentParams struct {
+	CommentID string
+}
+
+// ListCommentsParams represents parameters for listing comments
+type ListCommentsParams struct {
+	Page         *int
+	Limit        *int
+	ObjectType   *CommentObjectType
+	ObjectID     *string
+	AuthorUserID *string
+}
+
+// project represents a project retrieved from API
+type project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// projectList represents the project list response
+type projectList struct {
+	Data []project `json:"data"`
+}
+
+// CreateComment creates a comment on a trace, observation, session or prompt
+func (c *Client) CreateComment(ctx context.Context, params CreateCommentParams) (*CreateCommentResponse, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.ObjectType == "" || params.ObjectID == "" {
+		return nil, fmt.Errorf("objectType and objectID are required")
+	}
+	if params.Content == "" {
+		return nil, fmt.Errorf("comment content is required")
+	}
+
+	if params.ProjectID == "" {
+		projectID, err := c.projectID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		params.ProjectID = projectID
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/comments", c.config.BaseURL)
+
+	resp, err := c.sendJSON(ctx, http.MethodPost, endpoint, params, &CreateCommentResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return resp.(*CreateCommentResponse), nil
+}
+
+// GetComment retrieves a comment by ID
+func (c *Client) GetComment(ctx context.Context, params GetCommentParams) (*Comment, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.CommentID == "" {
+		return nil, fmt.Errorf("commentID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/comments/%s", c.config.BaseURL, url.PathEscape(params.CommentID))
+
+	comment, err := c.fetchJSON(ctx, endpoint, &Comment{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	return comment.(*Comment), nil
+}
+
+// ListComments retrieves a paginated list of comments
+func (c *Client) ListComments(ctx context.Context, params ListCommentsParams) (*PaginatedComments, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/comments", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.ObjectType != nil {
+		queryParams.Set("objectType", string(*params.ObjectType))
+	}
+	if params.ObjectID != nil {
+		queryParams.Set("objectId", *params.ObjectID)
+	}
+	if params.AuthorUserID != nil {
+		queryParams.Set("authorUserId", *params.AuthorUserID)
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	comments, err := c.fetchJSON(ctx, fullURL, &PaginatedComments{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	return comments.(*PaginatedComments), nil
+}
+
+// CreateComment adds a comment to this trace. Queued events are flushed first
+// so the trace exists when the comment is created.
+func (t *Trace) CreateComment(ctx context.Context, params CreateCommentParams) (*CreateCommentResponse, error) {
+	return t.client.CreateTraceComment(ctx, t.id, params)
+}
+
+// ListComments retrieves the comments on this trace
+func (t *Trace) ListComments(ctx context.Context) (*PaginatedComments, error) {
+	return t.client.ListComments(ctx, ListCommentsParams{
+		ObjectType: Ptr(CommentObjectTrace),
+		ObjectID:   Ptr(t.id),
+	})
+}
+
+// CreateTraceComment adds a comment to a trace, flushing queued events first
+func (c *Client) CreateTraceComment(ctx context.Context, traceID string, params CreateCommentParams) (*CreateCommentResponse, error) {
+	return c.createObjectComment(ctx, CommentObjectTrace, traceID, params)
+}
+
+// CreateObservationComment adds a comment to an observation, flushing queued events first
+func (c *Client) CreateObservationComment(ctx context.Context, observationID string, params CreateCommentParams) (*CreateCommentResponse, error) {
+	return c.createObjectComment(ctx, CommentObjectObservation, observationID, params)
+}
+
+// CreateSessionComment adds a comment to a session, flushing queued events first
+func (c *Client) CreateSessionComment(ctx context.Context, sessionID string, params CreateCommentParams) (*CreateCommentResponse, error) {
+	return c.createObjectComment(ctx, CommentObjectSession, sessionID, params)
+}
+
+// createObjectComment flushes pending events and comments on the given object
+func (c *Client) createObjectComment(ctx context.Context, objectType CommentObjectType, objectID string, params CreateCommentParams) (*CreateCommentResponse, error) {
+	if err := c.Flush(ctx); err != nil {
+		return nil, fmt.Errorf("failed to flush events before commenting: %w", err)
+	}
+
+	params.ObjectType = objectType
+	params.ObjectID = objectID
+	return c.CreateComment(ctx, params)
+}
+
+// projectID returns the ID of the project the API keys belong to
+func (c *Client) projectID(ctx context.Context) (string, error) {
+	c.projectMu.Lock()
+	defer c.projectMu.Unlock()
+
+	if c.cachedProjectID != "" {
+		return c.cachedProjectID, nil
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/projects", c.config.BaseURL)
+
+	resp, err := c.fetchJSON(ctx, endpoint, &projectList{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get project: %w", err)
+	}
+
+	projects := resp.(*projectList)
+	if len(projects.Data) == 0 {
+		return "", fmt.Errorf("no project found for API keys")
+	}
+
+	c.cachedProjectID = projects.Data[0].ID
+	return c.cachedProjectID, nil
+}
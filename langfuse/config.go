@@ -1,6 +1,7 @@
 package langfuse
 
 import (
+	"log/slog"
 	"time"
 )
 
@@ -39,6 +40,11 @@ type Config struct {
 	// Debug enables debug logging (default: false)
 	Debug bool
 
+	// Logger receives the SDK's structured logs (default: slog.Default(), or a
+	// debug-level stderr logger when Debug is set). Debug details are logged
+	// at slog.LevelDebug; dropped and failed events at slog.LevelWarn and above.
+	Logger *slog.Logger
+
 	// MaxRetryAttempts is the maximum number of retry attempts for retryable errors (default: 5)
 	MaxRetryAttempts int
 
@@ -51,6 +57,35 @@ type Config struct {
 	// MetricsEnabled enables metrics collection (default: false)
 	MetricsEnabled bool
 
+	// CostCalculator fills in generation costs from model prices before they
+	// are queued (optional, see Client.LoadCostCalculator)
+	CostCalculator *CostCalculator
+
+	// ValidateScores checks scores that reference a ConfigID against the score
+	// config before they are queued (default: false)
+	ValidateScores bool
+
+	// Sampler decides which traces are sent; observations and scores follow
+	// their trace (optional, default: all traces are sent)
+	Sampler Sampler
+
+	// SamplingMode controls whether rejected traces are dropped outright or
+	// kept if they turn out to contain errors (default: SamplingModeHead)
+	SamplingMode SamplingMode
+
+	// Truncation limits the size of Input, Output and Metadata so that single
+	// oversized events do not fail a batch (optional, see DefaultTruncationConfig)
+	Truncation *TruncationConfig
+
+	// Mask redacts sensitive data in Input, Output and Metadata before events
+	// are queued (optional, e.g. MaskStrings(DefaultRedactors()...))
+	Mask MaskFunc
+
+	// UploadMedia uploads base64 data URIs, []byte values and *Media found in
+	// Input, Output and Metadata to Langfuse and replaces them with media
+	// references (default: false)
+	UploadMedia bool
+
 	// OnEventFlushed is called after each flush with success and error counts
 	OnEventFlushed func(successCount, errorCount int)
 
@@ -73,6 +108,7 @@ func DefaultConfig() *Config {
 		RetryBaseDelay:   5 * time.Second,
 		RetryMaxDelay:    30 * time.Second,
 		MetricsEnabled:   false,
+		SamplingMode:     SamplingModeHead,
 	}
 }
 
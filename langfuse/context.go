@@ -0,0 +1,42 @@
+package langfuse
+
+import "context"
+
+// contextKey is the key for the active trace in a context
+type contextKey struct{}
+
+// activeSpan is the trace and, optionally, observation carried by a context
+type activeSpan struct {
+	trace         *Trace
+	observationID string
+}
+
+// ContextWithTrace returns a context carrying the trace, so that code further
+// down the call chain (e.g. LogHandler) can attach data to it
+func ContextWithTrace(ctx context.Context, trace *Trace) context.Context {
+	return context.WithValue(ctx, contextKey{}, activeSpan{trace: trace})
+}
+
+// ContextWithObservation returns a context carrying the trace and one of its
+// observations, which becomes the parent of observations attached via the context
+func ContextWithObservation(ctx context.Context, trace *Trace, observationID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, activeSpan{trace: trace, observationID: observationID})
+}
+
+// TraceFromContext returns the trace carried by the context, if any
+func TraceFromContext(ctx context.Context) (*Trace, bool) {
+	span, ok := ctx.Value(contextKey{}).(activeSpan)
+	if !ok || span.trace == nil {
+		return nil, false
+	}
+	return span.trace, true
+}
+
+// ObservationIDFromContext returns the observation carried by the context, if any
+func ObservationIDFromContext(ctx context.Context) (string, bool) {
+	span, ok := ctx.Value(contextKey{}).(activeSpan)
+	if !ok || span.observationID == "" {
+		return "", false
+	}
+	return span.observationID, true
+}
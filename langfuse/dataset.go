@@ -0,0 +1,193 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CreateDataset creates a new dataset
+func (c *Client) CreateDataset(ctx context.Context, params CreateDatasetParams) (*Dataset, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.Name == "" {
+		return nil, fmt.Errorf("dataset name is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/v2/datasets", c.config.BaseURL)
+
+	dataset, err := c.sendJSON(ctx, http.MethodPost, endpoint, params, &Dataset{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dataset: %w", err)
+	}
+
+	return dataset.(*Dataset), nil
+}
+
+// GetDataset retrieves a dataset by name
+func (c *Client) GetDataset(ctx context.Context, params GetDatasetParams) (*Dataset, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.DatasetName == "" {
+		return nil, fmt.Errorf("dataset name is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/v2/datasets/%s", c.config.BaseURL, url.PathEscape(params.DatasetName))
+
+	dataset, err := c.fetchJSON(ctx, endpoint, &Dataset{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dataset: %w", err)
+	}
+
+	return dataset.(*Dataset), nil
+}
+
+// ListDatasets retrieves a paginated list of datasets
+func (c *Client) ListDatasets(ctx context.Context, params ListDatasetsParams) (*PaginatedDatasets, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/v2/datasets", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	datasets, err := c.fetchJSON(ctx, fullURL, &PaginatedDatasets{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list datasets: %w", err)
+	}
+
+	return datasets.(*PaginatedDatasets), nil
+}
+
+// CreateDatasetItem creates a dataset item, or updates it if params.ID refers
+// to an existing item
+func (c *Client) CreateDatasetItem(ctx context.Context, params CreateDatasetItemParams) (*DatasetItem, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.DatasetName == "" {
+		return nil, fmt.Errorf("dataset name is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/dataset-items", c.config.BaseURL)
+
+	item, err := c.sendJSON(ctx, http.MethodPost, endpoint, params, &DatasetItem{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dataset item: %w", err)
+	}
+
+	return item.(*DatasetItem), nil
+}
+
+// UpsertDatasetItem creates or updates the dataset item identified by params.ID
+func (c *Client) UpsertDatasetItem(ctx context.Context, params CreateDatasetItemParams) (*DatasetItem, error) {
+	if params.ID == nil || *params.ID == "" {
+		return nil, fmt.Errorf("dataset item ID is required for upsert")
+	}
+
+	return c.CreateDatasetItem(ctx, params)
+}
+
+// GetDatasetItem retrieves a single dataset item by ID
+func (c *Client) GetDatasetItem(ctx context.Context, params GetDatasetItemParams) (*DatasetItem, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.ItemID == "" {
+		return nil, fmt.Errorf("itemID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/dataset-items/%s", c.config.BaseURL, url.PathEscape(params.ItemID))
+
+	item, err := c.fetchJSON(ctx, endpoint, &DatasetItem{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dataset item: %w", err)
+	}
+
+	return item.(*DatasetItem), nil
+}
+
+// ListDatasetItems retrieves a paginated list of dataset items
+func (c *Client) ListDatasetItems(ctx context.Context, params ListDatasetItemsParams) (*PaginatedDatasetItems, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/dataset-items", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.DatasetName != nil {
+		queryParams.Set("datasetName", *params.DatasetName)
+	}
+	if params.SourceTraceID != nil {
+		queryParams.Set("sourceTraceId", *params.SourceTraceID)
+	}
+	if params.SourceObservationID != nil {
+		queryParams.Set("sourceObservationId", *params.SourceObservationID)
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	items, err := c.fetchJSON(ctx, fullURL, &PaginatedDatasetItems{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dataset items: %w", err)
+	}
+
+	return items.(*PaginatedDatasetItems), nil
+}
+
+// CreateDatasetRunItem links a trace or observation to a dataset item under
+// the named run. The run is created on first use.
+func (c *Client) CreateDatasetRunItem(ctx context.Context, params CreateDatasetRunItemParams) (*DatasetRunItem, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.RunName == "" {
+		return nil, fmt.Errorf("run name is required")
+	}
+	if params.DatasetItemID == "" {
+		return nil, fmt.Errorf("dataset item ID is required")
+	}
+	if params.TraceID == nil && params.ObservationID == nil {
+		return nil, fmt.Errorf("traceID or observationID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/dataset-run-items", c.config.BaseURL)
+
+	runItem, err := c.sendJSON(ctx, http.MethodPost, endpoint, params, &DatasetRunItem{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dataset run item: %w", err)
+	}
+
+	return runItem.(*DatasetRunItem), nil
+}
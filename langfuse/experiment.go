@@ -0,0 +1,351 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ExperimentTask runs the code under test for a single dataset item. The trace
+// created for the item is passed in so the task can attach observations to it.
+type ExperimentTask func(ctx context.Context, item DatasetItem, trace *Trace) (interface{}, error)
+
+// ExperimentEvaluator scores the output of a task for a single dataset item.
+// Returned scores are attached to the item's trace unless they set their own target.
+type ExperimentEvaluator func(ctx context.Context, item DatasetItem, output interface{}) ([]ScoreParams, error)
+
+// ExperimentParams contains parameters for running an experiment over a dataset
+type ExperimentParams struct {
+	// DatasetName is the name of the dataset to run over (required)
+	DatasetName string
+
+	// RunName is the name of the dataset run the traces are linked to (required)
+	RunName string
+
+	// RunDescription is an optional description of the run
+	RunDescription *string
+
+	// Metadata is attached to every trace and to the dataset run
+	Metadata map[string]interface{}
+
+	// TraceName is the name of the trace created per item (default: "experiment-" + RunName)
+	TraceName *string
+
+	// Concurrency is the number of items processed in parallel (default: 1)
+	Concurrency int
+
+	// Task is the function executed for every dataset item (required)
+	Task ExperimentTask
+
+	// Evaluators are executed on every successful task output
+	Evaluators []ExperimentEvaluator
+}
+
+// ExperimentItemResult holds the outcome of running the task on one dataset item
+type ExperimentItemResult struct {
+	Item    DatasetItem
+	TraceID string
+	Output  interface{}
+	Scores  []ScoreParams
+	Latency time.Duration
+
+	// Err is set when the task, an evaluator or linking the run item failed.
+	// Several failures are combined with errors.Join, first cause first.
+	Err error
+
+	// Linked reports whether the trace was linked to the dataset run
+	Linked bool
+
+	// taskSucceeded reports whether the task ran to completion, so only real
+	// task latencies are aggregated
+	taskSucceeded bool
+}
+
+// ExperimentReport aggregates the results of an experiment run
+type ExperimentReport struct {
+	DatasetName  string
+	RunName      string
+	Results      []ExperimentItemResult
+	ItemCount    int
+	FailureCount int
+
+	// MeanScores maps numeric and boolean score names to the mean of their
+	// values across items
+	MeanScores map[string]float64
+
+	// Latency statistics cover only items whose task succeeded
+	AverageLatency time.Duration
+	P50Latency     time.Duration
+	P95Latency     time.Duration
+	MaxLatency     time.Duration
+	Duration       time.Duration
+}
+
+// Failures returns the results of items that failed
+func (r *ExperimentReport) Failures() []ExperimentItemResult {
+	var failures []ExperimentItemResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// Unlinked returns the results whose trace was not linked to the dataset run
+func (r *ExperimentReport) Unlinked() []ExperimentItemResult {
+	var unlinked []ExperimentItemResult
+	for _, result := range r.Results {
+		if !result.Linked {
+			unlinked = append(unlinked, result)
+		}
+	}
+	return unlinked
+}
+
+// RunExperiment runs params.Task over every active item of a dataset, creating a
+// trace per item, linking it to the named dataset run and scoring it with the
+// configured evaluators. If ctx is cancelled, the report for the items that
+// were started is returned together with ctx.Err().
+func (c *Client) RunExperiment(ctx context.Context, params ExperimentParams) (*ExperimentReport, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.DatasetName == "" {
+		return nil, fmt.Errorf("dataset name is required")
+	}
+	if params.RunName == "" {
+		return nil, fmt.Errorf("run name is required")
+	}
+	if params.Task == nil {
+		return nil, fmt.Errorf("task is required")
+	}
+
+	concurrency := params.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	items, err := c.fetchAllDatasetItems(ctx, params.DatasetName)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	results := make([]ExperimentItemResult, len(items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	started := 0
+	for ; started < len(items); started++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = c.runExperimentItem(ctx, params, items[i])
+		}(started)
+	}
+	wg.Wait()
+	results = results[:started]
+
+	if err := ctx.Err(); err != nil {
+		report := buildExperimentReport(params, results)
+		report.Duration = time.Since(start)
+		return report, err
+	}
+
+	// Traces should be ingested before run items reference them. If the flush
+	// fails the traces stay queued and are sent later, so the run items are
+	// linked regardless.
+	flushErr := c.Flush(ctx)
+	for i := range results {
+		c.linkExperimentRunItem(ctx, params, &results[i])
+	}
+
+	report := buildExperimentReport(params, results)
+	report.Duration = time.Since(start)
+
+	if flushErr != nil {
+		return report, fmt.Errorf("failed to flush traces: %w", flushErr)
+	}
+	return report, nil
+}
+
+// fetchAllDatasetItems pages through all active items of a dataset
+func (c *Client) fetchAllDatasetItems(ctx context.Context, datasetName string) ([]DatasetItem, error) {
+	var items []DatasetItem
+
+	for page := 1; ; page++ {
+		resp, err := c.ListDatasetItems(ctx, ListDatasetItemsParams{
+			Page:        Ptr(page),
+			DatasetName: Ptr(datasetName),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp.Data {
+			if item.Status == "" || item.Status == DatasetItemStatusActive {
+				items = append(items, item)
+			}
+		}
+
+		if len(resp.Data) == 0 || page >= resp.Meta.TotalPages {
+			break
+		}
+	}
+
+	return items, nil
+}
+
+// runExperimentItem executes the task and evaluators for a single item
+func (c *Client) runExperimentItem(ctx context.Context, params ExperimentParams, item DatasetItem) ExperimentItemResult {
+	result := ExperimentItemResult{Item: item}
+
+	traceName := "experiment-" + params.RunName
+	if params.TraceName != nil {
+		traceName = *params.TraceName
+	}
+
+	metadata := make(map[string]interface{}, len(params.Metadata)+2)
+	for k, v := range params.Metadata {
+		metadata[k] = v
+	}
+	metadata["dataset_item_id"] = item.ID
+	metadata["dataset_run_name"] = params.RunName
+
+	trace, err := c.CreateTrace(TraceParams{
+		Name:     Ptr(traceName),
+		Input:    item.Input,
+		Metadata: metadata,
+	})
+	if err != nil {
+		result.Err = fmt.Errorf("failed to create trace: %w", err)
+		return result
+	}
+	result.TraceID = trace.ID()
+
+	taskStart := time.Now()
+	output, taskErr := params.Task(ctx, item, trace)
+	result.Latency = time.Since(taskStart)
+	result.Output = output
+
+	result.taskSucceeded = taskErr == nil
+
+	if taskErr != nil {
+		result.Err = fmt.Errorf("task failed: %w", taskErr)
+		_ = trace.Update(TraceParams{Metadata: map[string]interface{}{"error": taskErr.Error()}})
+	} else {
+		_ = trace.Update(TraceParams{Output: output})
+
+		for _, evaluator := range params.Evaluators {
+			scores, err := evaluator(ctx, item, output)
+			if err != nil {
+				result.Err = errors.Join(result.Err, fmt.Errorf("evaluator failed: %w", err))
+				continue
+			}
+			for _, score := range scores {
+				if score.TraceID == nil && score.ObservationID == nil {
+					score.TraceID = Ptr(trace.ID())
+				}
+				if _, err := c.CreateScore(score); err != nil {
+					result.Err = errors.Join(result.Err, fmt.Errorf("failed to create score %q: %w", score.Name, err))
+					continue
+				}
+				result.Scores = append(result.Scores, score)
+			}
+		}
+	}
+
+	return result
+}
+
+// linkExperimentRunItem links the trace of an item result to the dataset run
+func (c *Client) linkExperimentRunItem(ctx context.Context, params ExperimentParams, result *ExperimentItemResult) {
+	if result.TraceID == "" {
+		return
+	}
+
+	_, err := c.CreateDatasetRunItem(ctx, CreateDatasetRunItemParams{
+		RunName:        params.RunName,
+		RunDescription: params.RunDescription,
+		Metadata:       params.Metadata,
+		DatasetItemID:  result.Item.ID,
+		TraceID:        Ptr(result.TraceID),
+	})
+	if err != nil {
+		result.Err = errors.Join(result.Err, err)
+		return
+	}
+	result.Linked = true
+}
+
+// buildExperimentReport aggregates item results into a report
+func buildExperimentReport(params ExperimentParams, results []ExperimentItemResult) *ExperimentReport {
+	report := &ExperimentReport{
+		DatasetName: params.DatasetName,
+		RunName:     params.RunName,
+		Results:     results,
+		ItemCount:   len(results),
+		MeanScores:  make(map[string]float64),
+	}
+
+	scoreSums := make(map[string]float64)
+	scoreCounts := make(map[string]int)
+	latencies := make([]time.Duration, 0, len(results))
+	var totalLatency time.Duration
+
+	for _, result := range results {
+		if result.Err != nil {
+			report.FailureCount++
+		}
+		for _, score := range result.Scores {
+			// Categorical labels have no meaningful mean
+			if score.dataType() == ScoreDataTypeCategorical {
+				continue
+			}
+			scoreSums[score.Name] += score.Value
+			scoreCounts[score.Name]++
+		}
+		if !result.taskSucceeded {
+			continue
+		}
+		latencies = append(latencies, result.Latency)
+		totalLatency += result.Latency
+	}
+
+	for name, sum := range scoreSums {
+		report.MeanScores[name] = sum / float64(scoreCounts[name])
+	}
+
+	if len(latencies) > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		report.AverageLatency = totalLatency / time.Duration(len(latencies))
+		report.P50Latency = percentileDuration(latencies, 0.50)
+		report.P95Latency = percentileDuration(latencies, 0.95)
+		report.MaxLatency = latencies[len(latencies)-1]
+	}
+
+	return report
+}
+
+// percentileDuration returns the p-th percentile of sorted durations
+func percentileDuration(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(float64(len(sorted)-1) * p)
+	return sorted[idx]
+}
@@ -1,13 +1,17 @@
 package langfuse
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // TraceWithFullDetails represents a trace with all nested observations
@@ -26,7 +30,8 @@ type TraceWithFullDetails struct {
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for TraceWithFullDetails
-// to handle cases where observations might be a string, null, or array
+// to handle cases where observations might be a string, null, or array.
+// Observations that fail to decode are reported as an error.
 func (t *TraceWithFullDetails) UnmarshalJSON(data []byte) error {
 	// Define a local type to avoid infinite recursion
 	type Alias TraceWithFullDetails
@@ -42,53 +47,120 @@ func (t *TraceWithFullDetails) UnmarshalJSON(data []byte) error {
 	}
 
 	// Handle observations field
-	if len(aux.Observations) > 0 && string(aux.Observations) != "null" {
-		// Try to unmarshal as array first
-		var obsArray []ObservationDetails
-		if err := json.Unmarshal(aux.Observations, &obsArray); err != nil {
-			// If that fails, it might be a string or other format
-			// For now, just leave it empty
-			t.Observations = nil
-		} else {
-			t.Observations = obsArray
+	if len(aux.Observations) == 0 || string(aux.Observations) == "null" {
+		return nil
+	}
+
+	raw := []byte(aux.Observations)
+
+	// Some endpoints return observations as a JSON-encoded string
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err == nil {
+		if encoded == "" {
+			return nil
 		}
+		raw = []byte(encoded)
+	}
+
+	var obsArray []ObservationDetails
+	if err := json.Unmarshal(raw, &obsArray); err != nil {
+		return fmt.Errorf("failed to decode observations of trace %s: %w", t.ID, err)
 	}
+	t.Observations = obsArray
 
 	return nil
 }
 
 // ScoreData represents a score retrieved from API
 type ScoreData struct {
-	ID            string   `json:"id"`
-	TraceID       string   `json:"traceId"`
-	ObservationID *string  `json:"observationId,omitempty"`
-	Name          string   `json:"name"`
-	Value         float64  `json:"value"`
-	Comment       *string  `json:"comment,omitempty"`
-	DataType      string   `json:"dataType"`
-	ConfigID      *string  `json:"configId,omitempty"`
-	Timestamp     string   `json:"timestamp"`
+	ID            string  `json:"id"`
+	TraceID       string  `json:"traceId"`
+	ObservationID *string `json:"observationId,omitempty"`
+	Name          string  `json:"name"`
+	Value         float64 `json:"value"`
+	StringValue   *string `json:"stringValue,omitempty"`
+	Comment       *string `json:"comment,omitempty"`
+	DataType      string  `json:"dataType"`
+	ConfigID      *string `json:"configId,omitempty"`
+	Source        string  `json:"source,omitempty"`
+	Environment   *string `json:"environment,omitempty"`
+	Timestamp     string  `json:"timestamp"`
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling for ScoreData to handle
+// categorical scores whose value is returned as a string or null
+func (s *ScoreData) UnmarshalJSON(data []byte) error {
+	type Alias ScoreData
+	aux := &struct {
+		Value json.RawMessage `json:"value"`
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.Value) == 0 || string(aux.Value) == "null" {
+		return nil
+	}
+
+	var number float64
+	if err := json.Unmarshal(aux.Value, &number); err == nil {
+		s.Value = number
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(aux.Value, &str); err != nil {
+		return fmt.Errorf("invalid score value %s: %w", aux.Value, err)
+	}
+	if s.StringValue == nil {
+		s.StringValue = &str
+	}
+
+	return nil
+}
+
+// BoolValue returns the value of a BOOLEAN score
+func (s ScoreData) BoolValue() bool {
+	return s.Value == 1
 }
 
 // ObservationDetails represents an observation (span, generation, event, tool)
 type ObservationDetails struct {
-	ID                string         `json:"id"`
-	TraceID           string         `json:"traceId"`
-	Type              string         `json:"type"` // SPAN, GENERATION, EVENT, TOOL
-	Name              *string        `json:"name,omitempty"`
-	StartTime         string         `json:"startTime"`
-	EndTime           *string        `json:"endTime,omitempty"`
-	CompletionStartTime *string      `json:"completionStartTime,omitempty"`
-	Input             interface{}    `json:"input,omitempty"`
-	Output            interface{}    `json:"output,omitempty"`
-	Metadata          map[string]interface{} `json:"metadata,omitempty"`
-	Level             *string        `json:"level,omitempty"`
-	StatusMessage     *string        `json:"statusMessage,omitempty"`
-	ParentObservationID *string      `json:"parentObservationId,omitempty"`
-	Version           *string        `json:"version,omitempty"`
-	Model             *string        `json:"model,omitempty"`
-	ModelParameters   map[string]interface{} `json:"modelParameters,omitempty"`
-	Usage             *Usage         `json:"usage,omitempty"`
+	ID                  string                 `json:"id"`
+	TraceID             string                 `json:"traceId"`
+	Type                ObservationType        `json:"type"`
+	Name                *string                `json:"name,omitempty"`
+	StartTime           time.Time              `json:"startTime"`
+	EndTime             *time.Time             `json:"endTime,omitempty"`
+	CompletionStartTime *time.Time             `json:"completionStartTime,omitempty"`
+	Input               interface{}            `json:"input,omitempty"`
+	Output              interface{}            `json:"output,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+	Level               *ObservationLevel      `json:"level,omitempty"`
+	StatusMessage       *string                `json:"statusMessage,omitempty"`
+	ParentObservationID *string                `json:"parentObservationId,omitempty"`
+	Version             *string                `json:"version,omitempty"`
+	Environment         *string                `json:"environment,omitempty"`
+	Model               *string                `json:"model,omitempty"`
+	ModelParameters     map[string]interface{} `json:"modelParameters,omitempty"`
+	PromptID            *string                `json:"promptId,omitempty"`
+	Usage               *Usage                 `json:"usage,omitempty"`
+	UsageDetails        UsageDetails           `json:"usageDetails,omitempty"`
+	CostDetails         CostDetails            `json:"costDetails,omitempty"`
+	Latency             *float64               `json:"latency,omitempty"` // seconds
+}
+
+// Duration returns the time between start and end of the observation, or 0
+// if it has not ended
+func (o ObservationDetails) Duration() time.Duration {
+	if o.EndTime == nil {
+		return 0
+	}
+	return o.EndTime.Sub(o.StartTime)
 }
 
 // SessionWithTraces represents a session with its traces
@@ -98,10 +170,55 @@ type SessionWithTraces struct {
 	Traces    []TraceWithFullDetails `json:"traces"`
 }
 
+// Session represents a session retrieved from the session list API
+type Session struct {
+	ID          string  `json:"id"`
+	CreatedAt   string  `json:"createdAt"`
+	ProjectID   string  `json:"projectId"`
+	Environment *string `json:"environment,omitempty"`
+}
+
+// PaginatedSessions represents paginated session list response
+type PaginatedSessions struct {
+	Data []Session      `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// TraceWithDetails represents a trace as returned by the trace list API.
+// Observations and Scores hold IDs only; use GetTrace for the full objects.
+type TraceWithDetails struct {
+	ID           string                 `json:"id"`
+	Timestamp    time.Time              `json:"timestamp"`
+	Name         *string                `json:"name,omitempty"`
+	UserID       *string                `json:"userId,omitempty"`
+	SessionID    *string                `json:"sessionId,omitempty"`
+	Release      *string                `json:"release,omitempty"`
+	Version      *string                `json:"version,omitempty"`
+	Environment  *string                `json:"environment,omitempty"`
+	Public       bool                   `json:"public"`
+	Input        interface{}            `json:"input,omitempty"`
+	Output       interface{}            `json:"output,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	Tags         []string               `json:"tags,omitempty"`
+	HTMLPath     string                 `json:"htmlPath"`
+	Latency      *float64               `json:"latency,omitempty"`   // seconds
+	TotalCost    *float64               `json:"totalCost,omitempty"` // USD
+	Observations []string               `json:"observations,omitempty"`
+	Scores       []string               `json:"scores,omitempty"`
+}
+
+// LatencyDuration returns the trace latency as a time.Duration
+func (t TraceWithDetails) LatencyDuration() time.Duration {
+	if t.Latency == nil {
+		return 0
+	}
+	return time.Duration(*t.Latency * float64(time.Second))
+}
+
 // PaginatedTraces represents paginated trace list response
 type PaginatedTraces struct {
-	Data       []TraceWithFullDetails `json:"data"`
-	Meta       PaginationMeta         `json:"meta"`
+	Data []TraceWithDetails `json:"data"`
+	Meta PaginationMeta     `json:"meta"`
 }
 
 // PaginationMeta represents pagination metadata
@@ -117,16 +234,37 @@ type GetTraceParams struct {
 	TraceID string
 }
 
+// TraceField selects a group of fields returned by the trace list API
+type TraceField string
+
+const (
+	TraceFieldCore         TraceField = "core"
+	TraceFieldIO           TraceField = "io"
+	TraceFieldScores       TraceField = "scores"
+	TraceFieldObservations TraceField = "observations"
+	TraceFieldMetrics      TraceField = "metrics"
+)
+
 // ListTracesParams represents parameters for listing traces
 type ListTracesParams struct {
-	Page      *int
-	Limit     *int
-	UserID    *string
-	Name      *string
-	SessionID *string
-	FromTimestamp *string
-	ToTimestamp   *string
-	Tags      []string
+	Page          *int
+	Limit         *int
+	UserID        *string
+	Name          *string
+	SessionID     *string
+	FromTimestamp *time.Time
+	ToTimestamp   *time.Time
+	Tags          []string
+	Version       *string
+	Release       *string
+	Environment   *string
+
+	// OrderBy sorts results as "field.direction", e.g. "timestamp.desc"
+	OrderBy *string
+
+	// Fields limits the returned field groups, e.g. to skip io and observations
+	// (default: all fields)
+	Fields []TraceField
 }
 
 // GetSessionParams represents parameters for fetching a session
@@ -134,6 +272,201 @@ type GetSessionParams struct {
 	SessionID string
 }
 
+// ListSessionsParams represents parameters for listing sessions
+type ListSessionsParams struct {
+	Page          *int
+	Limit         *int
+	FromTimestamp *time.Time
+	ToTimestamp   *time.Time
+	Environment   *string
+}
+
+// PaginatedObservations represents paginated observation list response
+type PaginatedObservations struct {
+	Data []ObservationDetails `json:"data"`
+	Meta PaginationMeta       `json:"meta"`
+}
+
+// ListObservationsParams represents parameters for listing observations
+type ListObservationsParams struct {
+	Page                *int
+	Limit               *int
+	Name                *string
+	UserID              *string
+	Type                *ObservationType
+	TraceID             *string
+	ParentObservationID *string
+	Level               *ObservationLevel
+	Environment         *string
+	Version             *string
+	FromStartTime       *time.Time
+	ToStartTime         *time.Time
+}
+
+// GetObservationParams represents parameters for fetching a single observation
+type GetObservationParams struct {
+	ObservationID string
+}
+
+// ScoreSource represents how a score was produced
+type ScoreSource string
+
+const (
+	ScoreSourceAPI        ScoreSource = "API"
+	ScoreSourceEval       ScoreSource = "EVAL"
+	ScoreSourceAnnotation ScoreSource = "ANNOTATION"
+)
+
+// PaginatedScores represents paginated score list response
+type PaginatedScores struct {
+	Data []ScoreData    `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// ListScoresParams represents parameters for listing scores
+type ListScoresParams struct {
+	Page          *int
+	Limit         *int
+	UserID        *string
+	Name          *string
+	DataType      *string
+	Source        *ScoreSource
+	ConfigID      *string
+	Environment   *string
+	TraceTags     []string
+	FromTimestamp *time.Time
+	ToTimestamp   *time.Time
+
+	// Operator and Value filter numeric scores, e.g. Operator ">=" and Value 0.5.
+	// Supported operators: <, >, <=, >=, =, !=
+	Operator *string
+	Value    *float64
+}
+
+// GetScoreParams represents parameters for fetching a score
+type GetScoreParams struct {
+	ScoreID string
+}
+
+// DeleteScoreParams represents parameters for deleting a score
+type DeleteScoreParams struct {
+	ScoreID string
+}
+
+// DatasetItemStatus represents the status of a dataset item
+type DatasetItemStatus string
+
+const (
+	DatasetItemStatusActive   DatasetItemStatus = "ACTIVE"
+	DatasetItemStatusArchived DatasetItemStatus = "ARCHIVED"
+)
+
+// Dataset represents a dataset retrieved from API
+type Dataset struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description *string                `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	ProjectID   string                 `json:"projectId"`
+	CreatedAt   string                 `json:"createdAt"`
+	UpdatedAt   string                 `json:"updatedAt"`
+}
+
+// DatasetItem represents a single item of a dataset
+type DatasetItem struct {
+	ID                  string                 `json:"id"`
+	Status              DatasetItemStatus      `json:"status"`
+	Input               interface{}            `json:"input,omitempty"`
+	ExpectedOutput      interface{}            `json:"expectedOutput,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+	SourceTraceID       *string                `json:"sourceTraceId,omitempty"`
+	SourceObservationID *string                `json:"sourceObservationId,omitempty"`
+	DatasetID           string                 `json:"datasetId"`
+	DatasetName         string                 `json:"datasetName"`
+	CreatedAt           string                 `json:"createdAt"`
+	UpdatedAt           string                 `json:"updatedAt"`
+}
+
+// DatasetRunItem links a trace or observation to a dataset item within a run
+type DatasetRunItem struct {
+	ID             string  `json:"id"`
+	DatasetRunID   string  `json:"datasetRunId"`
+	DatasetRunName string  `json:"datasetRunName"`
+	DatasetItemID  string  `json:"datasetItemId"`
+	TraceID        string  `json:"traceId"`
+	ObservationID  *string `json:"observationId,omitempty"`
+	CreatedAt      string  `json:"createdAt"`
+	UpdatedAt      string  `json:"updatedAt"`
+}
+
+// PaginatedDatasets represents paginated dataset list response
+type PaginatedDatasets struct {
+	Data []Dataset      `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// PaginatedDatasetItems represents paginated dataset item list response
+type PaginatedDatasetItems struct {
+	Data []DatasetItem  `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// CreateDatasetParams represents parameters for creating a dataset
+type CreateDatasetParams struct {
+	Name        string                 `json:"name"`
+	Description *string                `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// GetDatasetParams represents parameters for fetching a dataset
+type GetDatasetParams struct {
+	DatasetName string
+}
+
+// ListDatasetsParams represents parameters for listing datasets
+type ListDatasetsParams struct {
+	Page  *int
+	Limit *int
+}
+
+// CreateDatasetItemParams represents parameters for creating a dataset item.
+// Items with an existing ID are updated instead (upsert).
+type CreateDatasetItemParams struct {
+	ID                  *string                `json:"id,omitempty"`
+	DatasetName         string                 `json:"datasetName"`
+	Input               interface{}            `json:"input,omitempty"`
+	ExpectedOutput      interface{}            `json:"expectedOutput,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+	SourceTraceID       *string                `json:"sourceTraceId,omitempty"`
+	SourceObservationID *string                `json:"sourceObservationId,omitempty"`
+	Status              *DatasetItemStatus     `json:"status,omitempty"`
+}
+
+// GetDatasetItemParams represents parameters for fetching a dataset item
+type GetDatasetItemParams struct {
+	ItemID string
+}
+
+// ListDatasetItemsParams represents parameters for listing dataset items
+type ListDatasetItemsParams struct {
+	Page                *int
+	Limit               *int
+	DatasetName         *string
+	SourceTraceID       *string
+	SourceObservationID *string
+}
+
+// CreateDatasetRunItemParams represents parameters for linking a trace or
+// observation to a dataset item under a named run
+type CreateDatasetRunItemParams struct {
+	RunName        string                 `json:"runName"`
+	RunDescription *string                `json:"runDescription,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	DatasetItemID  string                 `json:"datasetItemId"`
+	TraceID        *string                `json:"traceId,omitempty"`
+	ObservationID  *string                `json:"observationId,omitempty"`
+}
+
 // GetTrace retrieves a single trace by ID with all its observations
 func (c *Client) GetTrace(ctx context.Context, params GetTraceParams) (*TraceWithFullDetails, error) {
 	if !c.config.Enabled {
@@ -179,14 +512,33 @@ func (c *Client) ListTraces(ctx context.Context, params ListTracesParams) (*Pagi
 		queryParams.Set("sessionId", *params.SessionID)
 	}
 	if params.FromTimestamp != nil {
-		queryParams.Set("fromTimestamp", *params.FromTimestamp)
+		queryParams.Set("fromTimestamp", params.FromTimestamp.UTC().Format(time.RFC3339Nano))
 	}
 	if params.ToTimestamp != nil {
-		queryParams.Set("toTimestamp", *params.ToTimestamp)
+		queryParams.Set("toTimestamp", params.ToTimestamp.UTC().Format(time.RFC3339Nano))
 	}
 	for _, tag := range params.Tags {
 		queryParams.Add("tags", tag)
 	}
+	if params.Version != nil {
+		queryParams.Set("version", *params.Version)
+	}
+	if params.Release != nil {
+		queryParams.Set("release", *params.Release)
+	}
+	if params.Environment != nil {
+		queryParams.Set("environment", *params.Environment)
+	}
+	if params.OrderBy != nil {
+		queryParams.Set("orderBy", *params.OrderBy)
+	}
+	if len(params.Fields) > 0 {
+		fields := make([]string, len(params.Fields))
+		for i, field := range params.Fields {
+			fields[i] = string(field)
+		}
+		queryParams.Set("fields", strings.Join(fields, ","))
+	}
 
 	fullURL := baseURL
 	if len(queryParams) > 0 {
@@ -221,20 +573,257 @@ func (c *Client) GetSession(ctx context.Context, params GetSessionParams) (*Sess
 	return session.(*SessionWithTraces), nil
 }
 
+// ListSessions retrieves a paginated list of sessions
+func (c *Client) ListSessions(ctx context.Context, params ListSessionsParams) (*PaginatedSessions, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/sessions", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.FromTimestamp != nil {
+		queryParams.Set("fromTimestamp", params.FromTimestamp.UTC().Format(time.RFC3339Nano))
+	}
+	if params.ToTimestamp != nil {
+		queryParams.Set("toTimestamp", params.ToTimestamp.UTC().Format(time.RFC3339Nano))
+	}
+	if params.Environment != nil {
+		queryParams.Set("environment", *params.Environment)
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	sessions, err := c.fetchJSON(ctx, fullURL, &PaginatedSessions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	return sessions.(*PaginatedSessions), nil
+}
+
+// ListObservations retrieves a paginated list of observations across traces
+func (c *Client) ListObservations(ctx context.Context, params ListObservationsParams) (*PaginatedObservations, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/observations", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.Name != nil {
+		queryParams.Set("name", *params.Name)
+	}
+	if params.UserID != nil {
+		queryParams.Set("userId", *params.UserID)
+	}
+	if params.Type != nil {
+		queryParams.Set("type", string(*params.Type))
+	}
+	if params.TraceID != nil {
+		queryParams.Set("traceId", *params.TraceID)
+	}
+	if params.ParentObservationID != nil {
+		queryParams.Set("parentObservationId", *params.ParentObservationID)
+	}
+	if params.Level != nil {
+		queryParams.Set("level", string(*params.Level))
+	}
+	if params.Environment != nil {
+		queryParams.Set("environment", *params.Environment)
+	}
+	if params.Version != nil {
+		queryParams.Set("version", *params.Version)
+	}
+	if params.FromStartTime != nil {
+		queryParams.Set("fromStartTime", params.FromStartTime.UTC().Format(time.RFC3339Nano))
+	}
+	if params.ToStartTime != nil {
+		queryParams.Set("toStartTime", params.ToStartTime.UTC().Format(time.RFC3339Nano))
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	observations, err := c.fetchJSON(ctx, fullURL, &PaginatedObservations{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list observations: %w", err)
+	}
+
+	return observations.(*PaginatedObservations), nil
+}
+
+// GetObservation retrieves a single observation by ID
+func (c *Client) GetObservation(ctx context.Context, params GetObservationParams) (*ObservationDetails, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.ObservationID == "" {
+		return nil, fmt.Errorf("observationID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/observations/%s", c.config.BaseURL, url.PathEscape(params.ObservationID))
+
+	observation, err := c.fetchJSON(ctx, endpoint, &ObservationDetails{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get observation: %w", err)
+	}
+
+	return observation.(*ObservationDetails), nil
+}
+
+// ListScores retrieves a paginated list of scores
+func (c *Client) ListScores(ctx context.Context, params ListScoresParams) (*PaginatedScores, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if (params.Operator == nil) != (params.Value == nil) {
+		return nil, fmt.Errorf("operator and value must be set together")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/v2/scores", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.UserID != nil {
+		queryParams.Set("userId", *params.UserID)
+	}
+	if params.Name != nil {
+		queryParams.Set("name", *params.Name)
+	}
+	if params.DataType != nil {
+		queryParams.Set("dataType", *params.DataType)
+	}
+	if params.Source != nil {
+		queryParams.Set("source", string(*params.Source))
+	}
+	if params.ConfigID != nil {
+		queryParams.Set("configId", *params.ConfigID)
+	}
+	if params.Environment != nil {
+		queryParams.Set("environment", *params.Environment)
+	}
+	for _, tag := range params.TraceTags {
+		queryParams.Add("traceTags", tag)
+	}
+	if params.FromTimestamp != nil {
+		queryParams.Set("fromTimestamp", params.FromTimestamp.UTC().Format(time.RFC3339Nano))
+	}
+	if params.ToTimestamp != nil {
+		queryParams.Set("toTimestamp", params.ToTimestamp.UTC().Format(time.RFC3339Nano))
+	}
+	if params.Operator != nil {
+		queryParams.Set("operator", *params.Operator)
+		queryParams.Set("value", strconv.FormatFloat(*params.Value, 'f', -1, 64))
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	scores, err := c.fetchJSON(ctx, fullURL, &PaginatedScores{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scores: %w", err)
+	}
+
+	return scores.(*PaginatedScores), nil
+}
+
+// GetScore retrieves a single score by ID
+func (c *Client) GetScore(ctx context.Context, params GetScoreParams) (*ScoreData, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.ScoreID == "" {
+		return nil, fmt.Errorf("scoreID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/v2/scores/%s", c.config.BaseURL, url.PathEscape(params.ScoreID))
+
+	score, err := c.fetchJSON(ctx, endpoint, &ScoreData{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get score: %w", err)
+	}
+
+	return score.(*ScoreData), nil
+}
+
+// DeleteScore deletes a score by ID
+func (c *Client) DeleteScore(ctx context.Context, params DeleteScoreParams) error {
+	if !c.config.Enabled {
+		return fmt.Errorf("client is disabled")
+	}
+
+	if params.ScoreID == "" {
+		return fmt.Errorf("scoreID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/scores/%s", c.config.BaseURL, url.PathEscape(params.ScoreID))
+
+	if _, err := c.sendJSON(ctx, http.MethodDelete, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete score: %w", err)
+	}
+
+	return nil
+}
+
 // fetchJSON is a helper method to make GET requests and parse JSON responses
 func (c *Client) fetchJSON(ctx context.Context, url string, target interface{}) (interface{}, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	return c.sendJSON(ctx, http.MethodGet, url, nil, target)
+}
+
+// sendJSON is a helper method to make requests with an optional JSON payload
+// and parse JSON responses. A nil target discards the response body.
+func (c *Client) sendJSON(ctx context.Context, method, url string, payload interface{}, target interface{}) (interface{}, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", c.makeAuthHeader())
 	req.Header.Set("Accept", "application/json")
-
-	if c.config.Debug {
-		fmt.Printf("[Langfuse] GET %s\n", url)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
 	}
 
+	c.logger.Debug("sending API request", slog.String("method", method), slog.String("url", url))
+
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, NewNetworkError(err)
@@ -246,17 +835,22 @@ func (c *Client) fetchJSON(ctx context.Context, url string, target interface{})
 		return nil, NewNetworkError(err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return nil, NewHTTPError(resp.StatusCode, string(body))
 	}
 
-	if err := json.Unmarshal(body, target); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	if target != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, target); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
 	}
 
-	if c.config.Debug {
-		fmt.Printf("[Langfuse] Successfully fetched data from %s\n", url)
-	}
+	c.logger.Debug("API request completed",
+		slog.String("method", method),
+		slog.String("url", url),
+		slog.Int("status", resp.StatusCode),
+		slog.Duration("latency", time.Since(start)),
+	)
 
 	return target, nil
 }
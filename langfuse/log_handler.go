@@ -0,0 +1,208 @@
+package langfuse
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// sdkLogAttr is added to the SDK's own logger; records carrying it are not
+// mirrored, so logging about the queue cannot feed back into the queue
+const sdkLogAttr = "sdk"
+
+// LogHandlerOptions configures a LogHandler
+type LogHandlerOptions struct {
+	// Level is the minimum level mirrored into Langfuse (default: slog.LevelInfo)
+	Level slog.Leveler
+
+	// SdkLogWithoutTrace sends records outside a traced context as sdk-log
+	// events instead of ignoring them (default: false)
+	SdkLogWithoutTrace bool
+}
+
+// LogHandler is a slog.Handler that passes records to a wrapped handler and
+// mirrors them into Langfuse. Records logged with a context carrying a trace
+// (see ContextWithTrace and ContextWithObservation) become event observations
+// named after the message, with the level mapped to an ObservationLevel and
+// the attributes as metadata.
+type LogHandler struct {
+	client *Client
+	next   slog.Handler
+	opts   LogHandlerOptions
+
+	attrs    []slog.Attr
+	groups   []string
+	internal bool
+}
+
+// NewLogHandler creates a handler that wraps next and mirrors records into
+// Langfuse via client
+func NewLogHandler(client *Client, next slog.Handler, opts *LogHandlerOptions) *LogHandler {
+	h := &LogHandler{client: client, next: next}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.Level == nil {
+		h.opts.Level = slog.LevelInfo
+	}
+	return h
+}
+
+// Enabled implements slog.Handler
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level) || (!h.internal && level >= h.opts.Level.Level())
+}
+
+// Handle implements slog.Handler
+func (h *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if !h.internal && record.Level >= h.opts.Level.Level() {
+		h.mirror(ctx, record)
+	}
+
+	if !h.next.Enabled(ctx, record.Level) {
+		return nil
+	}
+	return h.next.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.next = h.next.WithAttrs(attrs)
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), h.groupAttrs(attrs)...)
+	for _, attr := range attrs {
+		if attr.Key == sdkLogAttr && attr.Value.String() == "langfuse" && len(h.groups) == 0 {
+			clone.internal = true
+		}
+	}
+	return &clone
+}
+
+// WithGroup implements slog.Handler
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.next = h.next.WithGroup(name)
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
+
+// groupAttrs nests attrs in the handler's open groups
+func (h *LogHandler) groupAttrs(attrs []slog.Attr) []slog.Attr {
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		args := make([]any, len(attrs))
+		for j, attr := range attrs {
+			args[j] = attr
+		}
+		attrs = []slog.Attr{slog.Group(h.groups[i], args...)}
+	}
+	return attrs
+}
+
+// mirror sends a record to Langfuse. Errors are ignored so logging never fails
+// because of the SDK.
+func (h *LogHandler) mirror(ctx context.Context, record slog.Record) {
+	var recordAttrs []slog.Attr
+	record.Attrs(func(attr slog.Attr) bool {
+		recordAttrs = append(recordAttrs, attr)
+		return true
+	})
+
+	metadata := make(map[string]interface{}, len(h.attrs)+len(recordAttrs)+1)
+	for _, attr := range h.attrs {
+		addLogAttr(metadata, attr)
+	}
+	for _, attr := range h.groupAttrs(recordAttrs) {
+		addLogAttr(metadata, attr)
+	}
+
+	trace, ok := TraceFromContext(ctx)
+	if !ok {
+		if h.opts.SdkLogWithoutTrace && h.client != nil {
+			_ = h.client.CreateSdkLog(SdkLogParams{Log: map[string]interface{}{
+				"message":    record.Message,
+				"level":      record.Level.String(),
+				"time":       record.Time.Format(time.RFC3339Nano),
+				"attributes": metadata,
+			}})
+		}
+		return
+	}
+
+	metadata["level"] = record.Level.String()
+
+	params := EventParams{}
+	params.Name = Ptr(record.Message)
+	params.Level = Ptr(observationLevelForLog(record.Level))
+	params.Metadata = metadata
+	if !record.Time.IsZero() {
+		params.StartTime = Ptr(record.Time)
+	}
+	if record.Level >= slog.LevelWarn {
+		params.StatusMessage = Ptr(record.Message)
+	}
+	if observationID, ok := ObservationIDFromContext(ctx); ok {
+		params.ParentObservationID = Ptr(observationID)
+	}
+
+	_, _ = trace.CreateEvent(params)
+}
+
+// observationLevelForLog maps a slog level to an observation level
+func observationLevelForLog(level slog.Level) ObservationLevel {
+	switch {
+	case level >= slog.LevelError:
+		return LevelError
+	case level >= slog.LevelWarn:
+		return LevelWarning
+	case level >= slog.LevelInfo:
+		return LevelDefault
+	default:
+		return LevelDebug
+	}
+}
+
+// addLogAttr adds an attribute to metadata, turning groups into nested maps
+func addLogAttr(metadata map[string]interface{}, attr slog.Attr) {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		group := value.Group()
+		if len(group) == 0 {
+			return
+		}
+		target := metadata
+		if attr.Key != "" {
+			nested, ok := metadata[attr.Key].(map[string]interface{})
+			if !ok {
+				nested = make(map[string]interface{}, len(group))
+				metadata[attr.Key] = nested
+			}
+			target = nested
+		}
+		for _, a := range group {
+			addLogAttr(target, a)
+		}
+		return
+	}
+
+	if attr.Key == "" {
+		return
+	}
+
+	switch value.Kind() {
+	case slog.KindTime:
+		metadata[attr.Key] = value.Time().Format(time.RFC3339Nano)
+	case slog.KindDuration:
+		metadata[attr.Key] = value.Duration().String()
+	case slog.KindAny:
+		if err, ok := value.Any().(error); ok {
+			metadata[attr.Key] = err.Error()
+			return
+		}
+		metadata[attr.Key] = value.Any()
+	default:
+		metadata[attr.Key] = value.Any()
+	}
+}
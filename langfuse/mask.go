@@ -0,0 +1,303 @@
+package langfuse
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaskFunc masks sensitive data in Input, Output and Metadata before events are
+// queued. It is called for every value in those fields, starting with the field
+// itself, with path being the dot-separated location of the value in the event
+// body (e.g. "input.messages.0.content"). It returns the masked value and
+// whether it changed; values that changed are not descended into.
+type MaskFunc func(path string, value interface{}) (interface{}, bool)
+
+// Redactor replaces sensitive substrings of a string value
+type Redactor interface {
+	Redact(s string) (string, bool)
+}
+
+// regexRedactor replaces all matches of a pattern that pass an optional check
+type regexRedactor struct {
+	pattern     *regexp.Regexp
+	replacement string
+	valid       func(match string) bool
+}
+
+// Redact implements Redactor
+func (r *regexRedactor) Redact(s string) (string, bool) {
+	changed := false
+	result := r.pattern.ReplaceAllStringFunc(s, func(match string) string {
+		if r.valid != nil && !r.valid(match) {
+			return match
+		}
+		changed = true
+		return r.replacement
+	})
+	return result, changed
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+	// Phone numbers need a leading "+" or separated digit groups, so that
+	// timestamps, IDs and version numbers are left alone
+	phonePattern = regexp.MustCompile(`\+\d{1,3}(?:[ -]?\(\d{1,4}\))?(?:[ -]\d{2,4}){2,4}\b|\+\d{8,15}\b|(?:\(\d{2,4}\) ?|\b\d{2,4}[ -])\d{3,4}[ -]\d{3,4}\b`)
+
+	// Card numbers need their digit groups separated by spaces or dashes, as
+	// printed on the card; runs of digits are more often order or account IDs
+	creditCardPattern = regexp.MustCompile(`\b\d{4}(?:(?: \d{4}){2} \d{1,7}|(?:-\d{4}){2}-\d{1,7}| \d{6} \d{5}|-\d{6}-\d{5})\b`)
+
+	apiKeyPattern = regexp.MustCompile(`\b(?:sk|pk|rk)-[A-Za-z0-9_-]{16,}|\b(?:sk|pk)-lf-[A-Za-z0-9-]{16,}|\bAKIA[0-9A-Z]{16}\b|\bgh[pousr]_[A-Za-z0-9]{36,}\b|\bxox[abpr]-[A-Za-z0-9-]{10,}|\bAIza[0-9A-Za-z_-]{35}\b`)
+	jwtPattern    = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+)
+
+// EmailRedactor redacts email addresses
+func EmailRedactor() Redactor {
+	return &regexRedactor{pattern: emailPattern, replacement: "[REDACTED_EMAIL]"}
+}
+
+// PhoneRedactor redacts phone numbers with at least 7 digits that start with a
+// country code or separate their digit groups
+func PhoneRedactor() Redactor {
+	return &regexRedactor{
+		pattern:     phonePattern,
+		replacement: "[REDACTED_PHONE]",
+		valid: func(match string) bool {
+			return countDigits(match) >= 7
+		},
+	}
+}
+
+// CreditCardRedactor redacts credit card numbers written in separated digit
+// groups that pass the Luhn check
+func CreditCardRedactor() Redactor {
+	return &regexRedactor{pattern: creditCardPattern, replacement: "[REDACTED_CREDIT_CARD]", valid: luhnValid}
+}
+
+// APIKeyRedactor redacts common API key formats (OpenAI, Langfuse, AWS, GitHub, Slack, Google)
+func APIKeyRedactor() Redactor {
+	return &regexRedactor{pattern: apiKeyPattern, replacement: "[REDACTED_API_KEY]"}
+}
+
+// JWTRedactor redacts JSON Web Tokens
+func JWTRedactor() Redactor {
+	return &regexRedactor{pattern: jwtPattern, replacement: "[REDACTED_JWT]"}
+}
+
+// RegexRedactor redacts all matches of a custom pattern
+func RegexRedactor(pattern *regexp.Regexp, replacement string) Redactor {
+	return &regexRedactor{pattern: pattern, replacement: replacement}
+}
+
+// DefaultRedactors returns all built-in redactors. JWTs and API keys come first
+// so their digits are not partially matched as phone or card numbers.
+func DefaultRedactors() []Redactor {
+	return []Redactor{
+		JWTRedactor(),
+		APIKeyRedactor(),
+		EmailRedactor(),
+		CreditCardRedactor(),
+		PhoneRedactor(),
+	}
+}
+
+// MaskStrings returns a MaskFunc that applies the redactors to every string
+// value. Base64 data URIs are left for media upload.
+func MaskStrings(redactors ...Redactor) MaskFunc {
+	return func(path string, value interface{}) (interface{}, bool) {
+		s, ok := value.(string)
+		if !ok || dataURIPattern.MatchString(s) {
+			return value, false
+		}
+
+		changed := false
+		for _, r := range redactors {
+			var redacted bool
+			s, redacted = r.Redact(s)
+			changed = changed || redacted
+		}
+		return s, changed
+	}
+}
+
+// MaskPaths returns a MaskFunc that replaces the values at the given paths with
+// "[REDACTED]". Paths are dot-separated and "*" matches any single key or
+// index, e.g. "metadata.user.ssn" or "input.messages.*.password".
+func MaskPaths(paths ...string) MaskFunc {
+	patterns := make([][]string, len(paths))
+	for i, p := range paths {
+		patterns[i] = strings.Split(p, ".")
+	}
+
+	return func(path string, value interface{}) (interface{}, bool) {
+		segments := strings.Split(path, ".")
+		for _, pattern := range patterns {
+			if pathMatches(pattern, segments) {
+				return "[REDACTED]", true
+			}
+		}
+		return value, false
+	}
+}
+
+// ChainMasks combines several MaskFuncs, applying them in order
+func ChainMasks(masks ...MaskFunc) MaskFunc {
+	return func(path string, value interface{}) (interface{}, bool) {
+		changed := false
+		for _, mask := range masks {
+			var masked bool
+			value, masked = mask(path, value)
+			changed = changed || masked
+		}
+		return value, changed
+	}
+}
+
+// maskEvent applies the mask to the input, output and metadata of an event and
+// returns the number of values that were masked
+func maskEvent(mask MaskFunc, event *Event) int {
+	total := 0
+	copied := false
+	for _, field := range []string{"input", "output", "metadata"} {
+		value, ok := event.Body[field]
+		if !ok {
+			continue
+		}
+
+		masked, count := maskValue(mask, field, value)
+		if count == 0 {
+			continue
+		}
+		total += count
+
+		// Copy the body so shared maps from caller params are not modified
+		if !copied {
+			body := make(map[string]interface{}, len(event.Body))
+			for k, v := range event.Body {
+				body[k] = v
+			}
+			event.Body = body
+			copied = true
+		}
+		event.Body[field] = masked
+	}
+	return total
+}
+
+// maskValue masks value and its children, copying maps and slices rather than
+// modifying them in place. It returns the number of values that were masked.
+func maskValue(mask MaskFunc, path string, value interface{}) (interface{}, int) {
+	if masked, changed := mask(path, value); changed {
+		return masked, 1
+	}
+
+	switch v := value.(type) {
+	case nil, string, bool, float64, float32, int, int64, int32, []byte, *Media, Media:
+		return value, 0
+	case map[string]interface{}:
+		var out map[string]interface{}
+		total := 0
+		for k, item := range v {
+			masked, count := maskValue(mask, path+"."+k, item)
+			if count == 0 {
+				continue
+			}
+			total += count
+			if out == nil {
+				out = make(map[string]interface{}, len(v))
+				for k2, v2 := range v {
+					out[k2] = v2
+				}
+			}
+			out[k] = masked
+		}
+		if out == nil {
+			return value, 0
+		}
+		return out, total
+	case []interface{}:
+		var out []interface{}
+		total := 0
+		for i, item := range v {
+			masked, count := maskValue(mask, path+"."+strconv.Itoa(i), item)
+			if count == 0 {
+				continue
+			}
+			total += count
+			if out == nil {
+				out = make([]interface{}, len(v))
+				copy(out, v)
+			}
+			out[i] = masked
+		}
+		if out == nil {
+			return value, 0
+		}
+		return out, total
+	default:
+		// Other types (structs, typed slices and maps) are masked through their
+		// JSON form so their fields are visible to the mask
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return value, 0
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			return value, 0
+		}
+		masked, count := maskValue(mask, path, decoded)
+		if count == 0 {
+			return value, 0
+		}
+		return masked, count
+	}
+}
+
+// pathMatches reports whether path segments match a pattern with "*" wildcards
+func pathMatches(pattern, segments []string) bool {
+	if len(pattern) != len(segments) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// luhnValid reports whether the digits in s pass the Luhn checksum
+func luhnValid(s string) bool {
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// countDigits returns the number of ASCII digits in s
+func countDigits(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,464 @@
+package langfuse
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const (
+	// mediaUploadWorkers is the number of concurrent media uploads
+	mediaUploadWorkers = 4
+
+	// mediaUploadQueueSize is the number of uploads that can be pending; media
+	// beyond that is sent inline
+	mediaUploadQueueSize = 100
+
+	// mediaPutTimeout is the minimum time allowed for uploading the content
+	// itself, which can take much longer than an API request
+	mediaPutTimeout = 2 * time.Minute
+)
+
+// mediaReferencePrefix starts the reference strings that replace uploaded media
+const mediaReferencePrefix = "@@@langfuseMedia:"
+
+// dataURIPattern matches base64 data URIs such as "data:image/png;base64,iVBOR..."
+var dataURIPattern = regexp.MustCompile(`^data:([\w.+-]+/[\w.+-]+);base64,([A-Za-z0-9+/=\s]+)$`)
+
+// Media is a binary attachment in Input, Output or Metadata. When
+// Config.UploadMedia is set it is uploaded to Langfuse and replaced by a media
+// reference string before the event is queued.
+type Media struct {
+	ContentType string
+	Data        []byte
+
+	// source is how the media was provided: "bytes" or "base64_data_uri"
+	source string
+}
+
+// NewMedia creates a media attachment from raw bytes
+func NewMedia(contentType string, data []byte) *Media {
+	return &Media{ContentType: contentType, Data: data, source: "bytes"}
+}
+
+// sha256Hash returns the base64-encoded SHA-256 hash of the content
+func (m *Media) sha256Hash() string {
+	sum := sha256.Sum256(m.Data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// id returns the content-derived media ID used by Langfuse
+func (m *Media) id() string {
+	hash := strings.NewReplacer("+", "-", "/", "_").Replace(m.sha256Hash())
+	return hash[:22]
+}
+
+// referenceString returns the string that replaces the media in event bodies
+func (m *Media) referenceString() string {
+	source := m.source
+	if source == "" {
+		source = "bytes"
+	}
+	return fmt.Sprintf("%stype=%s|id=%s|source=%s@@@", mediaReferencePrefix, m.ContentType, m.id(), source)
+}
+
+// parseDataURI decodes a base64 data URI into a Media
+func parseDataURI(s string) (*Media, bool) {
+	if !strings.HasPrefix(s, "data:") {
+		return nil, false
+	}
+
+	matches := dataURIPattern.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, false
+	}
+
+	// The pattern allows line breaks in the payload, which the decoder rejects
+	payload := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, matches[2])
+
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, false
+	}
+
+	return &Media{ContentType: matches[1], Data: data, source: "base64_data_uri"}, true
+}
+
+// mediaUpload is a pending upload of one media item
+type mediaUpload struct {
+	media         *Media
+	hash          string
+	traceID       string
+	observationID string
+	field         string
+}
+
+// mediaUploadURLRequest represents the request for a media upload URL
+type mediaUploadURLRequest struct {
+	TraceID       string  `json:"traceId"`
+	ObservationID *string `json:"observationId,omitempty"`
+	ContentType   string  `json:"contentType"`
+	ContentLength int     `json:"contentLength"`
+	SHA256Hash    string  `json:"sha256Hash"`
+	Field         string  `json:"field"`
+}
+
+// mediaUploadURLResponse represents the response with the media upload URL.
+// UploadURL is nil if the content has been uploaded before.
+type mediaUploadURLResponse struct {
+	UploadURL *string `json:"uploadUrl"`
+	MediaID   string  `json:"mediaId"`
+}
+
+// mediaUploadResult reports the outcome of an upload back to Langfuse
+type mediaUploadResult struct {
+	UploadedAt       time.Time `json:"uploadedAt"`
+	UploadHTTPStatus int       `json:"uploadHttpStatus"`
+	UploadHTTPError  *string   `json:"uploadHttpError,omitempty"`
+	UploadTimeMs     int64     `json:"uploadTimeMs"`
+}
+
+// mediaUploader replaces media in event bodies with references and uploads
+// the content in the background
+type mediaUploader struct {
+	client *Client
+	queue  chan mediaUpload
+	wg     sync.WaitGroup
+
+	// putClient uploads content without the client-wide request timeout;
+	// uploads are bounded by mediaPutTimeout instead
+	putClient *http.Client
+
+	// mu guards sends on queue against close
+	mu     sync.Mutex
+	closed bool
+
+	// uploads tracks content hashes whose content was uploaded or is being
+	// uploaded, so the same content is not sent twice
+	uploadsMu sync.Mutex
+	uploads   map[string]*contentUpload
+}
+
+// contentUpload is the upload of one piece of content, shared by every field
+// that references it
+type contentUpload struct {
+	// done is closed when the upload finished; ok is set before that
+	done chan struct{}
+	ok   bool
+}
+
+// newMediaUploader creates a media uploader and starts its workers
+func newMediaUploader(client *Client) *mediaUploader {
+	u := &mediaUploader{
+		client:    client,
+		queue:     make(chan mediaUpload, mediaUploadQueueSize),
+		putClient: &http.Client{Transport: client.httpClient.Transport},
+		uploads:   make(map[string]*contentUpload),
+	}
+
+	for i := 0; i < mediaUploadWorkers; i++ {
+		u.wg.Add(1)
+		go func() {
+			defer u.wg.Done()
+			for upload := range u.queue {
+				if err := u.upload(upload); err != nil {
+					u.client.logger.Warn("media upload failed",
+						slog.String("trace_id", upload.traceID),
+						slog.String("field", upload.field),
+						slog.Any("error", err),
+					)
+				}
+			}
+		}()
+	}
+
+	return u
+}
+
+// close waits for pending uploads to finish
+func (u *mediaUploader) close() {
+	u.mu.Lock()
+	if !u.closed {
+		u.closed = true
+		close(u.queue)
+	}
+	u.mu.Unlock()
+
+	u.wg.Wait()
+}
+
+// processEvent replaces media in the input, output and metadata of an event
+func (u *mediaUploader) processEvent(event *Event) {
+	var traceID, observationID string
+
+	switch event.Type {
+	case EventTypeTraceCreate:
+		traceID, _ = event.Body["id"].(string)
+	case EventTypeScoreCreate, EventTypeSdkLog:
+		return
+	default:
+		traceID, _ = event.Body["traceId"].(string)
+		observationID, _ = event.Body["id"].(string)
+	}
+
+	// Uploads must be attached to a trace; updates without a trace ID keep media inline
+	if traceID == "" {
+		return
+	}
+
+	copied := false
+	for _, field := range []string{"input", "output", "metadata"} {
+		value, ok := event.Body[field]
+		if !ok {
+			continue
+		}
+
+		replaced, changed := u.replace(value, traceID, observationID, field)
+		if !changed {
+			continue
+		}
+
+		// Copy the body so shared maps from caller params are not modified
+		if !copied {
+			body := make(map[string]interface{}, len(event.Body))
+			for k, v := range event.Body {
+				body[k] = v
+			}
+			event.Body = body
+			copied = true
+		}
+		event.Body[field] = replaced
+	}
+}
+
+// replace returns value with all media replaced by reference strings. Maps and
+// slices are copied rather than modified in place.
+func (u *mediaUploader) replace(value interface{}, traceID, observationID, field string) (interface{}, bool) {
+	schedule := func(m *Media) (interface{}, bool) {
+		if !u.schedule(mediaUpload{media: m, traceID: traceID, observationID: observationID, field: field}) {
+			return value, false
+		}
+		return m.referenceString(), true
+	}
+
+	switch v := value.(type) {
+	case nil, bool, float64, float32, int, int64, int32:
+		return value, false
+	case *Media:
+		return schedule(v)
+	case Media:
+		return schedule(&v)
+	case []byte:
+		return schedule(&Media{ContentType: http.DetectContentType(v), Data: v, source: "bytes"})
+	case string:
+		if m, ok := parseDataURI(v); ok {
+			return schedule(m)
+		}
+		return value, false
+	case map[string]interface{}:
+		var out map[string]interface{}
+		for k, item := range v {
+			replaced, changed := u.replace(item, traceID, observationID, field)
+			if !changed {
+				continue
+			}
+			if out == nil {
+				out = make(map[string]interface{}, len(v))
+				for k2, v2 := range v {
+					out[k2] = v2
+				}
+			}
+			out[k] = replaced
+		}
+		if out == nil {
+			return value, false
+		}
+		return out, true
+	case []interface{}:
+		var out []interface{}
+		for i, item := range v {
+			replaced, changed := u.replace(item, traceID, observationID, field)
+			if !changed {
+				continue
+			}
+			if out == nil {
+				out = make([]interface{}, len(v))
+				copy(out, v)
+			}
+			out[i] = replaced
+		}
+		if out == nil {
+			return value, false
+		}
+		return out, true
+	default:
+		// Other types (structs, typed slices and maps) are inspected through
+		// their JSON form, skipping the decode when no data URI is present
+		encoded, err := json.Marshal(v)
+		if err != nil || !bytes.Contains(encoded, []byte(`"data:`)) {
+			return value, false
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			return value, false
+		}
+		return u.replace(decoded, traceID, observationID, field)
+	}
+}
+
+// schedule queues the media for upload. Every trace, observation and field
+// that references the media is registered with Langfuse, even if the content
+// itself was uploaded before. It returns false if the media should stay inline.
+func (u *mediaUploader) schedule(upload mediaUpload) bool {
+	upload.hash = upload.media.sha256Hash()
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.closed {
+		return false
+	}
+
+	select {
+	case u.queue <- upload:
+		return true
+	default:
+		u.client.logger.Warn("media upload queue is full, sending media inline",
+			slog.String("trace_id", upload.traceID),
+			slog.Int("bytes", len(upload.media.Data)),
+		)
+		return false
+	}
+}
+
+// upload requests an upload URL, uploads the content and reports the result.
+// If the same content is being uploaded for another field, it waits for that
+// upload and takes it over if it failed.
+func (u *mediaUploader) upload(upload mediaUpload) error {
+	req := mediaUploadURLRequest{
+		TraceID:       upload.traceID,
+		ContentType:   upload.media.ContentType,
+		ContentLength: len(upload.media.Data),
+		SHA256Hash:    upload.hash,
+		Field:         upload.field,
+	}
+	if upload.observationID != "" {
+		req.ObservationID = &upload.observationID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), u.client.config.Timeout)
+	defer cancel()
+
+	endpoint := fmt.Sprintf("%s/api/public/media", u.client.config.BaseURL)
+	resp, err := u.client.sendJSON(ctx, http.MethodPost, endpoint, req, &mediaUploadURLResponse{})
+	if err != nil {
+		return fmt.Errorf("failed to get media upload URL: %w", err)
+	}
+
+	uploadURL := resp.(*mediaUploadURLResponse)
+	if uploadURL.UploadURL == nil {
+		// Content already exists in Langfuse
+		return nil
+	}
+
+	for {
+		current, owner := u.claim(upload.hash)
+		if owner {
+			return u.put(upload, uploadURL, current)
+		}
+
+		// Another field referencing the same content is uploading it
+		<-current.done
+		if current.ok {
+			return nil
+		}
+	}
+}
+
+// claim returns the upload of the content with the given hash, and whether the
+// caller started it and must perform it
+func (u *mediaUploader) claim(hash string) (*contentUpload, bool) {
+	u.uploadsMu.Lock()
+	defer u.uploadsMu.Unlock()
+
+	if current, ok := u.uploads[hash]; ok {
+		return current, false
+	}
+
+	current := &contentUpload{done: make(chan struct{})}
+	u.uploads[hash] = current
+	return current, true
+}
+
+// finish records the outcome of a content upload and wakes up waiting uploads.
+// Failed uploads are forgotten so that the content can be uploaded again.
+func (u *mediaUploader) finish(hash string, current *contentUpload, ok bool) {
+	u.uploadsMu.Lock()
+	current.ok = ok
+	if !ok {
+		delete(u.uploads, hash)
+	}
+	u.uploadsMu.Unlock()
+
+	close(current.done)
+}
+
+// put uploads the content to the upload URL and reports the result
+func (u *mediaUploader) put(upload mediaUpload, uploadURL *mediaUploadURLResponse, current *contentUpload) error {
+	putCtx, cancel := context.WithTimeout(context.Background(), max(u.client.config.Timeout, mediaPutTimeout))
+	defer cancel()
+
+	start := time.Now()
+	putReq, err := http.NewRequestWithContext(putCtx, http.MethodPut, *uploadURL.UploadURL, bytes.NewReader(upload.media.Data))
+	if err != nil {
+		u.finish(upload.hash, current, false)
+		return fmt.Errorf("failed to create upload request: %w", err)
+	}
+	putReq.Header.Set("Content-Type", upload.media.ContentType)
+	putReq.Header.Set("x-amz-checksum-sha256", upload.hash)
+
+	result := mediaUploadResult{}
+	putResp, err := u.putClient.Do(putReq)
+	if err != nil {
+		result.UploadHTTPError = Ptr(err.Error())
+	} else {
+		putResp.Body.Close()
+		result.UploadHTTPStatus = putResp.StatusCode
+		if putResp.StatusCode < 200 || putResp.StatusCode >= 300 {
+			result.UploadHTTPError = Ptr(putResp.Status)
+		}
+	}
+	result.UploadedAt = time.Now().UTC()
+	result.UploadTimeMs = time.Since(start).Milliseconds()
+	u.finish(upload.hash, current, result.UploadHTTPError == nil)
+
+	patchCtx, cancel := context.WithTimeout(context.Background(), u.client.config.Timeout)
+	defer cancel()
+
+	patchEndpoint := fmt.Sprintf("%s/api/public/media/%s", u.client.config.BaseURL, url.PathEscape(uploadURL.MediaID))
+	if _, err := u.client.sendJSON(patchCtx, http.MethodPatch, patchEndpoint, result, nil); err != nil {
+		return fmt.Errorf("failed to report media upload: %w", err)
+	}
+
+	if result.UploadHTTPError != nil {
+		return fmt.Errorf("failed to upload media %s: %s", uploadURL.MediaID, *result.UploadHTTPError)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package langfuse
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestMediaUploadRegistersEveryReference(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		fields  []string
+		puts    int
+		server  *httptest.Server
+		patches int
+	)
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/public/media":
+			var req mediaUploadURLRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("decode media request: %v", err)
+			}
+			fields = append(fields, req.TraceID+"/"+req.Field)
+
+			// Only the first request for the content gets an upload URL
+			resp := mediaUploadURLResponse{MediaID: "media-1"}
+			if len(fields) == 1 {
+				resp.UploadURL = Ptr(server.URL + "/upload")
+			}
+			json.NewEncoder(w).Encode(resp)
+		case r.Method == http.MethodPut && r.URL.Path == "/upload":
+			puts++
+		case r.Method == http.MethodPatch:
+			patches++
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, func(c *Config) {
+		c.UploadMedia = true
+	})
+
+	dataURI := "data:image/png;base64," + base64.StdEncoding.EncodeToString([]byte("same image content"))
+	for _, id := range []string{"trace-1", "trace-2"} {
+		if _, err := client.CreateTrace(TraceParams{
+			ID:    Ptr(id),
+			Input: map[string]interface{}{"image": dataURI},
+		}); err != nil {
+			t.Fatalf("CreateTrace: %v", err)
+		}
+	}
+	client.media.close()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(fields) != 2 {
+		t.Fatalf("upload URL requested for %v, want one request per trace", fields)
+	}
+	if puts != 1 || patches != 1 {
+		t.Errorf("content uploaded %d times and reported %d times, want 1 and 1", puts, patches)
+	}
+}
+
+func TestMediaUploadSharesContentUploads(t *testing.T) {
+	tests := []struct {
+		name     string
+		failPuts int
+		wantPuts int
+		wantDone int
+	}{
+		{name: "duplicate waits for the upload", failPuts: 0, wantPuts: 1, wantDone: 1},
+		{name: "duplicate retries a failed upload", failPuts: 1, wantPuts: 2, wantDone: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu     sync.Mutex
+				puts   int
+				done   int
+				server *httptest.Server
+			)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				switch r.Method {
+				case http.MethodPost:
+					// Every request gets an upload URL, as for content that
+					// is not stored yet
+					json.NewEncoder(w).Encode(mediaUploadURLResponse{
+						MediaID:   "media-1",
+						UploadURL: Ptr(server.URL + "/upload"),
+					})
+				case http.MethodPut:
+					puts++
+					if puts <= tt.failPuts {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					done++
+				}
+			}))
+			defer server.Close()
+
+			client := newTestClient(t, server.URL, func(c *Config) {
+				c.UploadMedia = true
+			})
+
+			dataURI := "data:image/png;base64," + base64.StdEncoding.EncodeToString([]byte("same image content"))
+			for _, id := range []string{"trace-1", "trace-2"} {
+				if _, err := client.CreateTrace(TraceParams{
+					ID:    Ptr(id),
+					Input: map[string]interface{}{"image": dataURI},
+				}); err != nil {
+					t.Fatalf("CreateTrace: %v", err)
+				}
+			}
+			client.media.close()
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if puts != tt.wantPuts || done != tt.wantDone {
+				t.Errorf("content uploaded %d times with %d successes, want %d and %d", puts, done, tt.wantPuts, tt.wantDone)
+			}
+		})
+	}
+}
+
+func TestParseDataURI(t *testing.T) {
+	tests := []struct {
+		name   string
+		uri    string
+		want   string
+		wantOK bool
+	}{
+		{name: "plain", uri: "data:text/plain;base64,aGVsbG8=", want: "hello", wantOK: true},
+		{name: "line breaks", uri: "data:text/plain;base64,aGVs\r\nbG8=", want: "hello", wantOK: true},
+		{name: "not base64", uri: "data:text/plain,hello"},
+		{name: "invalid payload", uri: "data:text/plain;base64,a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			media, ok := parseDataURI(tt.uri)
+			if ok != tt.wantOK {
+				t.Fatalf("parseDataURI(%q) ok = %v, want %v", tt.uri, ok, tt.wantOK)
+			}
+			if ok && string(media.Data) != tt.want {
+				t.Errorf("parseDataURI(%q) = %q, want %q", tt.uri, media.Data, tt.want)
+			}
+		})
+	}
+}
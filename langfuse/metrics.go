@@ -1,7 +1,9 @@
 package langfuse
 
 import (
+	"expvar"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,17 +20,165 @@ type Metrics struct {
 	eventsFailed    int64
 	eventsDropped   int64
 
+	// fieldsMasked counts values redacted by Config.Mask
+	fieldsMasked int64
+
 	// Operation counters
 	flushCount int64
 	retryCount int64
 
 	// Timing
-	lastFlushTimeUnix int64 // Unix timestamp in nanoseconds
+	lastFlushTimeUnix   int64 // Unix timestamp in nanoseconds
+	lastSuccessTimeUnix int64 // Unix timestamp in nanoseconds
+	lastFailureTimeUnix int64 // Unix timestamp in nanoseconds
+	flushLatency        histogram
+	requestLatency      sampleWindow
+
+	// Throughput
+	bytesSent      int64
+	batchSizes     sampleWindow
+	peakQueueDepth int64
 
 	// Failed events for monitoring (limited size)
 	failedEvents []FailedEvent
 }
 
+// latencyBuckets are the upper bounds in seconds of latency histogram buckets
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// histogram counts observations in fixed buckets
+type histogram struct {
+	mu     sync.Mutex
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// observe records a value in seconds
+func (h *histogram) observe(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.counts == nil {
+		h.counts = make([]uint64, len(latencyBuckets))
+	}
+	for i, bound := range latencyBuckets {
+		if v <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// snapshot returns the histogram with cumulative bucket counts
+func (h *histogram) snapshot() HistogramSnapshot {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s := HistogramSnapshot{
+		Count:   h.count,
+		Sum:     h.sum,
+		Buckets: make([]HistogramBucket, len(latencyBuckets)),
+	}
+	var cumulative uint64
+	for i, bound := range latencyBuckets {
+		if h.counts != nil {
+			cumulative += h.counts[i]
+		}
+		s.Buckets[i] = HistogramBucket{UpperBound: bound, Count: cumulative}
+	}
+	return s
+}
+
+// reset clears all observations
+func (h *histogram) reset() {
+	h.mu.Lock()
+	h.counts = nil
+	h.count = 0
+	h.sum = 0
+	h.mu.Unlock()
+}
+
+// sampleWindowSize is the number of recent samples kept for percentiles
+const sampleWindowSize = 1024
+
+// sampleWindow keeps the most recent samples in a ring buffer
+type sampleWindow struct {
+	mu      sync.Mutex
+	samples []float64
+	next    int
+}
+
+// add records a sample, overwriting the oldest once the window is full
+func (w *sampleWindow) add(v float64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.samples) < sampleWindowSize {
+		w.samples = append(w.samples, v)
+		return
+	}
+	w.samples[w.next] = v
+	w.next = (w.next + 1) % sampleWindowSize
+}
+
+// percentiles summarizes the samples in the window
+func (w *sampleWindow) percentiles() Percentiles {
+	w.mu.Lock()
+	sorted := make([]float64, len(w.samples))
+	copy(sorted, w.samples)
+	w.mu.Unlock()
+
+	if len(sorted) == 0 {
+		return Percentiles{}
+	}
+	sort.Float64s(sorted)
+
+	at := func(p float64) float64 {
+		return sorted[int(p*float64(len(sorted)-1))]
+	}
+	return Percentiles{
+		Samples: len(sorted),
+		P50:     at(0.50),
+		P95:     at(0.95),
+		P99:     at(0.99),
+		Max:     sorted[len(sorted)-1],
+	}
+}
+
+// reset clears the window
+func (w *sampleWindow) reset() {
+	w.mu.Lock()
+	w.samples = nil
+	w.next = 0
+	w.mu.Unlock()
+}
+
+// Percentiles summarizes the most recent samples of a measurement
+type Percentiles struct {
+	Samples int
+	P50     float64
+	P95     float64
+	P99     float64
+	Max     float64
+}
+
+// HistogramBucket is the number of observations up to and including UpperBound
+type HistogramBucket struct {
+	UpperBound float64
+	Count      uint64
+}
+
+// HistogramSnapshot is a point-in-time copy of a histogram. Bucket counts are
+// cumulative; observations above the last bound only show up in Count.
+type HistogramSnapshot struct {
+	Count   uint64
+	Sum     float64
+	Buckets []HistogramBucket
+}
+
 // FailedEvent represents an event that failed to send
 type FailedEvent struct {
 	Event     Event
@@ -48,7 +198,15 @@ func (m *Metrics) RecordFlush(success, failed int) {
 	atomic.AddInt64(&m.eventsSucceeded, int64(success))
 	atomic.AddInt64(&m.eventsFailed, int64(failed))
 	atomic.AddInt64(&m.flushCount, 1)
-	atomic.StoreInt64(&m.lastFlushTimeUnix, time.Now().UnixNano())
+
+	now := time.Now().UnixNano()
+	atomic.StoreInt64(&m.lastFlushTimeUnix, now)
+	if success > 0 {
+		atomic.StoreInt64(&m.lastSuccessTimeUnix, now)
+	}
+	if failed > 0 {
+		atomic.StoreInt64(&m.lastFailureTimeUnix, now)
+	}
 }
 
 // RecordDropped records that events were dropped due to a full queue
@@ -56,6 +214,42 @@ func (m *Metrics) RecordDropped(count int) {
 	atomic.AddInt64(&m.eventsDropped, int64(count))
 }
 
+// RecordMasked records that values were redacted before enqueueing
+func (m *Metrics) RecordMasked(count int) {
+	atomic.AddInt64(&m.fieldsMasked, int64(count))
+}
+
+// RecordRequest records an ingestion request with its body size
+func (m *Metrics) RecordRequest(d time.Duration, bytes int) {
+	m.requestLatency.add(d.Seconds())
+	atomic.AddInt64(&m.bytesSent, int64(bytes))
+}
+
+// RecordBatch records the number of events sent in one flush
+func (m *Metrics) RecordBatch(size int) {
+	m.batchSizes.add(float64(size))
+}
+
+// RecordQueueDepth records the current queue depth, keeping track of the peak
+func (m *Metrics) RecordQueueDepth(depth int) {
+	for {
+		peak := atomic.LoadInt64(&m.peakQueueDepth)
+		if int64(depth) <= peak || atomic.CompareAndSwapInt64(&m.peakQueueDepth, peak, int64(depth)) {
+			return
+		}
+	}
+}
+
+// RecordFailure records that a flush failed or had events rejected
+func (m *Metrics) RecordFailure() {
+	atomic.StoreInt64(&m.lastFailureTimeUnix, time.Now().UnixNano())
+}
+
+// RecordFlushLatency records the duration of an ingestion request
+func (m *Metrics) RecordFlushLatency(d time.Duration) {
+	m.flushLatency.observe(d.Seconds())
+}
+
 // RecordRetry records that a retry attempt was made
 func (m *Metrics) RecordRetry() {
 	atomic.AddInt64(&m.retryCount, 1)
@@ -81,23 +275,33 @@ func (m *Metrics) RecordFailedEvent(event Event, err error, attempt int) {
 
 // GetSnapshot returns a snapshot of current metrics
 func (m *Metrics) GetSnapshot() MetricsSnapshot {
-	lastFlushUnix := atomic.LoadInt64(&m.lastFlushTimeUnix)
-	var lastFlush time.Time
-	if lastFlushUnix > 0 {
-		lastFlush = time.Unix(0, lastFlushUnix)
-	}
-
 	return MetricsSnapshot{
-		EventsEnqueued:  atomic.LoadInt64(&m.eventsEnqueued),
-		EventsFlushed:   atomic.LoadInt64(&m.eventsFlushed),
-		EventsSucceeded: atomic.LoadInt64(&m.eventsSucceeded),
-		EventsFailed:    atomic.LoadInt64(&m.eventsFailed),
-		EventsDropped:   atomic.LoadInt64(&m.eventsDropped),
-		FlushCount:      atomic.LoadInt64(&m.flushCount),
-		RetryCount:      atomic.LoadInt64(&m.retryCount),
-		LastFlushTime:   lastFlush,
+		EventsEnqueued:   atomic.LoadInt64(&m.eventsEnqueued),
+		EventsFlushed:    atomic.LoadInt64(&m.eventsFlushed),
+		EventsSucceeded:  atomic.LoadInt64(&m.eventsSucceeded),
+		EventsFailed:     atomic.LoadInt64(&m.eventsFailed),
+		EventsDropped:    atomic.LoadInt64(&m.eventsDropped),
+		FieldsMasked:     atomic.LoadInt64(&m.fieldsMasked),
+		FlushCount:       atomic.LoadInt64(&m.flushCount),
+		RetryCount:       atomic.LoadInt64(&m.retryCount),
+		LastFlushTime:    loadTime(&m.lastFlushTimeUnix),
+		FlushLatency:     m.flushLatency.snapshot(),
 		FailedEventCount: len(m.failedEvents),
+		PeakQueueDepth:   int(atomic.LoadInt64(&m.peakQueueDepth)),
+		RequestLatency:   m.requestLatency.percentiles(),
+		BatchSize:        m.batchSizes.percentiles(),
+		BytesSent:        atomic.LoadInt64(&m.bytesSent),
+		LastSuccessTime:  loadTime(&m.lastSuccessTimeUnix),
+		LastFailureTime:  loadTime(&m.lastFailureTimeUnix),
+	}
+}
+
+// loadTime reads a Unix nanosecond timestamp, returning the zero time if unset
+func loadTime(unixNano *int64) time.Time {
+	if v := atomic.LoadInt64(unixNano); v > 0 {
+		return time.Unix(0, v)
 	}
+	return time.Time{}
 }
 
 // GetFailedEvents returns a copy of the failed events list
@@ -117,9 +321,17 @@ func (m *Metrics) Reset() {
 	atomic.StoreInt64(&m.eventsSucceeded, 0)
 	atomic.StoreInt64(&m.eventsFailed, 0)
 	atomic.StoreInt64(&m.eventsDropped, 0)
+	atomic.StoreInt64(&m.fieldsMasked, 0)
 	atomic.StoreInt64(&m.flushCount, 0)
 	atomic.StoreInt64(&m.retryCount, 0)
 	atomic.StoreInt64(&m.lastFlushTimeUnix, 0)
+	atomic.StoreInt64(&m.lastSuccessTimeUnix, 0)
+	atomic.StoreInt64(&m.lastFailureTimeUnix, 0)
+	atomic.StoreInt64(&m.bytesSent, 0)
+	atomic.StoreInt64(&m.peakQueueDepth, 0)
+	m.flushLatency.reset()
+	m.requestLatency.reset()
+	m.batchSizes.reset()
 
 	m.mu.Lock()
 	m.failedEvents = nil
@@ -133,10 +345,35 @@ type MetricsSnapshot struct {
 	EventsSucceeded  int64
 	EventsFailed     int64
 	EventsDropped    int64
+	FieldsMasked     int64
 	FlushCount       int64
 	RetryCount       int64
 	LastFlushTime    time.Time
 	FailedEventCount int
+
+	// QueueDepth is the number of events waiting to be flushed
+	QueueDepth int
+
+	// PeakQueueDepth is the highest queue depth seen so far
+	PeakQueueDepth int
+
+	// FlushLatency is the distribution of ingestion request durations in seconds
+	FlushLatency HistogramSnapshot
+
+	// RequestLatency summarizes recent ingestion request durations in seconds
+	RequestLatency Percentiles
+
+	// BatchSize summarizes the number of events in recent flushes
+	BatchSize Percentiles
+
+	// BytesSent is the total size of ingestion request bodies
+	BytesSent int64
+
+	// LastSuccessTime is when events were last accepted by the ingestion API
+	LastSuccessTime time.Time
+
+	// LastFailureTime is when a flush last failed or had events rejected
+	LastFailureTime time.Time
 }
 
 // String returns a formatted string representation of the snapshot
@@ -147,9 +384,10 @@ func (s MetricsSnapshot) String() string {
 	}
 
 	return fmt.Sprintf(
-		"Enqueued: %d, Flushed: %d (Success: %d, Failed: %d), Dropped: %d, Retries: %d, Flushes: %d, LastFlush: %s",
+		"Enqueued: %d, Flushed: %d (Success: %d, Failed: %d), Dropped: %d, Masked: %d, Retries: %d, Flushes: %d, Queue: %d (peak %d), Latency p95: %.3fs, LastFlush: %s",
 		s.EventsEnqueued, s.EventsFlushed, s.EventsSucceeded, s.EventsFailed,
-		s.EventsDropped, s.RetryCount, s.FlushCount, lastFlush,
+		s.EventsDropped, s.FieldsMasked, s.RetryCount, s.FlushCount,
+		s.QueueDepth, s.PeakQueueDepth, s.RequestLatency.P95, lastFlush,
 	)
 }
 
@@ -168,3 +406,22 @@ func (s MetricsSnapshot) DropRate() float64 {
 	}
 	return (float64(s.EventsDropped) / float64(s.EventsEnqueued)) * 100.0
 }
+
+// expvarMu serializes PublishExpvar so the name check and expvar.Publish,
+// which panics on duplicate names, happen atomically
+var expvarMu sync.Mutex
+
+// PublishExpvar publishes the client's metrics snapshot under name in expvar,
+// e.g. for /debug/vars. It fails if the name is already taken.
+func (c *Client) PublishExpvar(name string) error {
+	expvarMu.Lock()
+	defer expvarMu.Unlock()
+
+	if expvar.Get(name) != nil {
+		return fmt.Errorf("expvar %q is already published", name)
+	}
+	expvar.Publish(name, expvar.Func(func() any {
+		return c.GetMetrics()
+	}))
+	return nil
+}
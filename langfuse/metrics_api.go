@@ -0,0 +1,199 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DailyMetrics represents aggregated project metrics for a single day
+type DailyMetrics struct {
+	// Date is the day in YYYY-MM-DD format
+	Date              string              `json:"date"`
+	CountTraces       int                 `json:"countTraces"`
+	CountObservations int                 `json:"countObservations"`
+	TotalCost         float64             `json:"totalCost"` // USD
+	Usage             []DailyModelMetrics `json:"usage"`
+}
+
+// DailyModelMetrics represents the metrics of a single model on a given day
+type DailyModelMetrics struct {
+	Model             *string `json:"model,omitempty"`
+	InputUsage        int     `json:"inputUsage"`
+	OutputUsage       int     `json:"outputUsage"`
+	TotalUsage        int     `json:"totalUsage"`
+	CountTraces       int     `json:"countTraces"`
+	CountObservations int     `json:"countObservations"`
+	TotalCost         float64 `json:"totalCost"` // USD
+}
+
+// PaginatedDailyMetrics represents paginated daily metrics response
+type PaginatedDailyMetrics struct {
+	Data []DailyMetrics `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// GetDailyMetricsParams represents parameters for fetching daily metrics
+type GetDailyMetricsParams struct {
+	Page          *int
+	Limit         *int
+	TraceName     *string
+	UserID        *string
+	Tags          []string
+	Environment   *string
+	FromTimestamp *time.Time
+	ToTimestamp   *time.Time
+}
+
+// MetricsQuery describes a query against the metrics API. See the Langfuse
+// metrics API documentation for the available views, dimensions and measures.
+type MetricsQuery struct {
+	// View is the data source, e.g. "traces", "observations", "scores-numeric"
+	View          string                `json:"view"`
+	Dimensions    []MetricsDimension    `json:"dimensions,omitempty"`
+	Metrics       []MetricsMeasure      `json:"metrics"`
+	Filters       []MetricsFilter       `json:"filters,omitempty"`
+	TimeDimension *MetricsTimeDimension `json:"timeDimension,omitempty"`
+	FromTimestamp time.Time             `json:"fromTimestamp"`
+	ToTimestamp   time.Time             `json:"toTimestamp"`
+	OrderBy       []MetricsOrderBy      `json:"orderBy,omitempty"`
+}
+
+// MetricsDimension groups query results by a field, e.g. "providedModelName"
+type MetricsDimension struct {
+	Field string `json:"field"`
+}
+
+// MetricsMeasure selects a measure and its aggregation, e.g. "totalCost" and "sum"
+type MetricsMeasure struct {
+	Measure     string `json:"measure"`
+	Aggregation string `json:"aggregation"`
+}
+
+// MetricsFilter restricts the rows a metrics query aggregates over
+type MetricsFilter struct {
+	Column   string      `json:"column"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
+	Type     string      `json:"type"`
+	Key      *string     `json:"key,omitempty"`
+}
+
+// MetricsTimeDimension buckets query results by time, e.g. granularity "day"
+type MetricsTimeDimension struct {
+	Granularity string `json:"granularity"`
+}
+
+// MetricsOrderBy sorts query results
+type MetricsOrderBy struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"` // "asc" or "desc"
+}
+
+// MetricsQueryResult holds the rows returned by a metrics query. Row keys are
+// the requested dimensions and "<aggregation>_<measure>" columns.
+type MetricsQueryResult struct {
+	Data []map[string]interface{} `json:"data"`
+}
+
+// GetDailyMetrics retrieves daily trace counts, observation counts, token usage
+// and cost, broken down by model
+func (c *Client) GetDailyMetrics(ctx context.Context, params GetDailyMetricsParams) (*PaginatedDailyMetrics, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/metrics/daily", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.TraceName != nil {
+		queryParams.Set("traceName", *params.TraceName)
+	}
+	if params.UserID != nil {
+		queryParams.Set("userId", *params.UserID)
+	}
+	for _, tag := range params.Tags {
+		queryParams.Add("tags", tag)
+	}
+	if params.Environment != nil {
+		queryParams.Set("environment", *params.Environment)
+	}
+	if params.FromTimestamp != nil {
+		queryParams.Set("fromTimestamp", params.FromTimestamp.UTC().Format(time.RFC3339Nano))
+	}
+	if params.ToTimestamp != nil {
+		queryParams.Set("toTimestamp", params.ToTimestamp.UTC().Format(time.RFC3339Nano))
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	metrics, err := c.fetchJSON(ctx, fullURL, &PaginatedDailyMetrics{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily metrics: %w", err)
+	}
+
+	return metrics.(*PaginatedDailyMetrics), nil
+}
+
+// QueryMetrics runs a query against the metrics API
+func (c *Client) QueryMetrics(ctx context.Context, query MetricsQuery) (*MetricsQueryResult, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if query.View == "" {
+		return nil, fmt.Errorf("metrics query view is required")
+	}
+	if len(query.Metrics) == 0 {
+		return nil, fmt.Errorf("at least one metric is required")
+	}
+
+	encoded, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metrics query: %w", err)
+	}
+
+	fullURL := fmt.Sprintf("%s/api/public/metrics?%s", c.config.BaseURL, url.Values{"query": {string(encoded)}}.Encode())
+
+	result, err := c.fetchJSON(ctx, fullURL, &MetricsQueryResult{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query metrics: %w", err)
+	}
+
+	return result.(*MetricsQueryResult), nil
+}
+
+// ModelTotals sums the per-model metrics across all days
+func (p *PaginatedDailyMetrics) ModelTotals() map[string]DailyModelMetrics {
+	totals := make(map[string]DailyModelMetrics)
+	for _, day := range p.Data {
+		for _, usage := range day.Usage {
+			model := ""
+			if usage.Model != nil {
+				model = *usage.Model
+			}
+			total := totals[model]
+			total.Model = usage.Model
+			total.InputUsage += usage.InputUsage
+			total.OutputUsage += usage.OutputUsage
+			total.TotalUsage += usage.TotalUsage
+			total.CountTraces += usage.CountTraces
+			total.CountObservations += usage.CountObservations
+			total.TotalCost += usage.TotalCost
+			totals[model] = total
+		}
+	}
+	return totals
+}
@@ -0,0 +1,394 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Model represents a model definition with its match pattern and prices
+type Model struct {
+	ID                string                 `json:"id"`
+	ModelName         string                 `json:"modelName"`
+	MatchPattern      string                 `json:"matchPattern"`
+	StartDate         *time.Time             `json:"startDate,omitempty"`
+	Unit              *string                `json:"unit,omitempty"`
+	InputPrice        *float64               `json:"inputPrice,omitempty"`
+	OutputPrice       *float64               `json:"outputPrice,omitempty"`
+	TotalPrice        *float64               `json:"totalPrice,omitempty"`
+	TokenizerID       *string                `json:"tokenizerId,omitempty"`
+	TokenizerConfig   map[string]interface{} `json:"tokenizerConfig,omitempty"`
+	IsLangfuseManaged bool                   `json:"isLangfuseManaged"`
+
+	// Prices maps usage types (e.g. "input", "input_cached_tokens") to their
+	// price per unit
+	Prices map[string]ModelPrice `json:"prices,omitempty"`
+}
+
+// ModelPrice is the price in USD per unit of one usage type
+type ModelPrice struct {
+	Price float64 `json:"price"`
+}
+
+// price returns the price per unit of a usage type, falling back to
+// InputPrice, OutputPrice and TotalPrice for "input", "output" and "total"
+func (m *Model) price(usageType string) (float64, bool) {
+	if p, ok := m.Prices[usageType]; ok {
+		return p.Price, true
+	}
+
+	var fallback *float64
+	switch usageType {
+	case "input":
+		fallback = m.InputPrice
+	case "output":
+		fallback = m.OutputPrice
+	case "total":
+		fallback = m.TotalPrice
+	}
+	if fallback == nil {
+		return 0, false
+	}
+	return *fallback, true
+}
+
+// PaginatedModels represents paginated model list response
+type PaginatedModels struct {
+	Data []Model        `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// CreateModelParams represents parameters for creating a custom model definition.
+// Prices are in USD per unit (e.g. per token).
+type CreateModelParams struct {
+	ModelName       string                 `json:"modelName"`
+	MatchPattern    string                 `json:"matchPattern"`
+	StartDate       *time.Time             `json:"startDate,omitempty"`
+	Unit            *string                `json:"unit,omitempty"`
+	InputPrice      *float64               `json:"inputPrice,omitempty"`
+	OutputPrice     *float64               `json:"outputPrice,omitempty"`
+	TotalPrice      *float64               `json:"totalPrice,omitempty"`
+	TokenizerID     *string                `json:"tokenizerId,omitempty"`
+	TokenizerConfig map[string]interface{} `json:"tokenizerConfig,omitempty"`
+}
+
+// GetModelParams represents parameters for fetching a model
+type GetModelParams struct {
+	ModelID string
+}
+
+// DeleteModelParams represents parameters for deleting a model
+type DeleteModelParams struct {
+	ModelID string
+}
+
+// ListModelsParams represents parameters for listing models
+type ListModelsParams struct {
+	Page  *int
+	Limit *int
+}
+
+// CreateModel creates a custom model definition
+func (c *Client) CreateModel(ctx context.Context, params CreateModelParams) (*Model, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.ModelName == "" || params.MatchPattern == "" {
+		return nil, fmt.Errorf("model name and match pattern are required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/models", c.config.BaseURL)
+
+	model, err := c.sendJSON(ctx, http.MethodPost, endpoint, params, &Model{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create model: %w", err)
+	}
+
+	return model.(*Model), nil
+}
+
+// GetModel retrieves a model definition by ID
+func (c *Client) GetModel(ctx context.Context, params GetModelParams) (*Model, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.ModelID == "" {
+		return nil, fmt.Errorf("modelID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/models/%s", c.config.BaseURL, url.PathEscape(params.ModelID))
+
+	model, err := c.fetchJSON(ctx, endpoint, &Model{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model: %w", err)
+	}
+
+	return model.(*Model), nil
+}
+
+// ListModels retrieves a paginated list of model definitions
+func (c *Client) ListModels(ctx context.Context, params ListModelsParams) (*PaginatedModels, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/models", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	models, err := c.fetchJSON(ctx, fullURL, &PaginatedModels{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list models: %w", err)
+	}
+
+	return models.(*PaginatedModels), nil
+}
+
+// DeleteModel deletes a custom model definition. Langfuse-managed models cannot be deleted.
+func (c *Client) DeleteModel(ctx context.Context, params DeleteModelParams) error {
+	if !c.config.Enabled {
+		return fmt.Errorf("client is disabled")
+	}
+
+	if params.ModelID == "" {
+		return fmt.Errorf("modelID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/models/%s", c.config.BaseURL, url.PathEscape(params.ModelID))
+
+	if _, err := c.sendJSON(ctx, http.MethodDelete, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete model: %w", err)
+	}
+
+	return nil
+}
+
+// CostCalculator fills in generation costs locally from cached model definitions
+type CostCalculator struct {
+	mu     sync.RWMutex
+	models []compiledModel
+
+	// logger reports models that are skipped (default: slog.Default())
+	logger *slog.Logger
+}
+
+// compiledModel is a model definition with its compiled match pattern
+type compiledModel struct {
+	model   Model
+	pattern *regexp.Regexp
+}
+
+// NewCostCalculator creates a cost calculator for the given model definitions
+func NewCostCalculator(models []Model) (*CostCalculator, error) {
+	calc := &CostCalculator{}
+	if err := calc.SetModels(models); err != nil {
+		return nil, err
+	}
+	return calc, nil
+}
+
+// LoadCostCalculator fetches all model definitions and returns a cost calculator for them
+func (c *Client) LoadCostCalculator(ctx context.Context) (*CostCalculator, error) {
+	var models []Model
+	for page := 1; ; page++ {
+		resp, err := c.ListModels(ctx, ListModelsParams{Page: Ptr(page), Limit: Ptr(100)})
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, resp.Data...)
+		if len(resp.Data) == 0 || page >= resp.Meta.TotalPages {
+			break
+		}
+	}
+
+	calc := &CostCalculator{logger: c.logger}
+	if err := calc.SetModels(models); err != nil {
+		return nil, err
+	}
+	return calc, nil
+}
+
+// SetModels replaces the cached model definitions. Models whose match pattern
+// does not compile as a Go regular expression (e.g. PCRE-only syntax) are
+// skipped with a warning; an error is returned only if no model is usable.
+func (cc *CostCalculator) SetModels(models []Model) error {
+	logger := cc.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	compiled := make([]compiledModel, 0, len(models))
+	for _, model := range models {
+		pattern, err := regexp.Compile(model.MatchPattern)
+		if err != nil {
+			logger.Warn("skipping model with invalid match pattern",
+				slog.String("model", model.ModelName),
+				slog.String("model_id", model.ID),
+				slog.String("match_pattern", model.MatchPattern),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		compiled = append(compiled, compiledModel{model: model, pattern: pattern})
+	}
+	if len(models) > 0 && len(compiled) == 0 {
+		return fmt.Errorf("no model has a valid match pattern")
+	}
+
+	cc.mu.Lock()
+	cc.models = compiled
+	cc.mu.Unlock()
+	return nil
+}
+
+// Match returns the model definition for a model name. Custom definitions take
+// precedence over Langfuse-managed ones, and among those the most recent
+// start date that is not in the future wins.
+func (cc *CostCalculator) Match(modelName string) (*Model, bool) {
+	cc.mu.RLock()
+	defer cc.mu.RUnlock()
+
+	now := time.Now()
+	var best *Model
+	for i := range cc.models {
+		candidate := &cc.models[i].model
+		if !cc.models[i].pattern.MatchString(modelName) {
+			continue
+		}
+		if candidate.StartDate != nil && candidate.StartDate.After(now) {
+			continue
+		}
+		if best == nil || modelPreferred(candidate, best) {
+			best = candidate
+		}
+	}
+
+	return best, best != nil
+}
+
+// modelPreferred reports whether a should be used instead of b
+func modelPreferred(a, b *Model) bool {
+	if a.IsLangfuseManaged != b.IsLangfuseManaged {
+		return !a.IsLangfuseManaged
+	}
+	if a.StartDate == nil {
+		return false
+	}
+	return b.StartDate == nil || a.StartDate.After(*b.StartDate)
+}
+
+// Calculate returns a copy of usage with InputCost, OutputCost and TotalCost
+// filled in from the matching model's prices. Costs already set are kept.
+// The second result is false if no model matched.
+func (cc *CostCalculator) Calculate(modelName string, usage Usage) (Usage, bool) {
+	model, ok := cc.Match(modelName)
+	if !ok {
+		return usage, false
+	}
+
+	input, output := derefInt(usage.Input), derefInt(usage.Output)
+	total := input + output
+	if usage.Total != nil {
+		total = *usage.Total
+	}
+
+	if usage.InputCost == nil && model.InputPrice != nil {
+		usage.InputCost = Ptr(float64(input) * *model.InputPrice)
+	}
+	if usage.OutputCost == nil && model.OutputPrice != nil {
+		usage.OutputCost = Ptr(float64(output) * *model.OutputPrice)
+	}
+	if usage.TotalCost == nil {
+		switch {
+		case model.TotalPrice != nil:
+			usage.TotalCost = Ptr(float64(total) * *model.TotalPrice)
+		case usage.InputCost != nil || usage.OutputCost != nil:
+			usage.TotalCost = Ptr(derefFloat(usage.InputCost) + derefFloat(usage.OutputCost))
+		}
+	}
+
+	return usage, true
+}
+
+// CalculateDetails returns the cost of each usage type that the matching
+// model has a price for, with "total" set to their sum. If only the total is
+// priced, it is used for usage["total"]. The second result is false if no
+// model matched or nothing could be priced.
+func (cc *CostCalculator) CalculateDetails(modelName string, usage UsageDetails) (CostDetails, bool) {
+	model, ok := cc.Match(modelName)
+	if !ok {
+		return nil, false
+	}
+
+	costs := make(CostDetails)
+	var sum float64
+	for usageType, units := range usage {
+		if usageType == "total" {
+			continue
+		}
+		if price, ok := model.price(usageType); ok {
+			costs[usageType] = float64(units) * price
+			sum += costs[usageType]
+		}
+	}
+
+	switch {
+	case len(costs) > 0:
+		costs["total"] = sum
+	case usage["total"] > 0:
+		if price, ok := model.price("total"); ok {
+			costs["total"] = float64(usage["total"]) * price
+		}
+	}
+
+	return costs, len(costs) > 0
+}
+
+// maxTrackedGenerations is the number of generation models remembered for
+// pricing updates that do not repeat the model. The oldest are forgotten first.
+const maxTrackedGenerations = 10000
+
+// rememberGenerationModel records the model a generation was created with
+func (c *Client) rememberGenerationModel(generationID, model string) {
+	c.generationModelMu.Lock()
+	defer c.generationModelMu.Unlock()
+
+	if c.generationModels == nil {
+		c.generationModels = make(map[string]string)
+	}
+	if _, ok := c.generationModels[generationID]; !ok {
+		c.generationModelOrder = append(c.generationModelOrder, generationID)
+	}
+	c.generationModels[generationID] = model
+
+	for len(c.generationModelOrder) > maxTrackedGenerations {
+		delete(c.generationModels, c.generationModelOrder[0])
+		c.generationModelOrder = c.generationModelOrder[1:]
+	}
+}
+
+// generationModel returns the model a generation was created with, or ""
+func (c *Client) generationModel(generationID string) string {
+	c.generationModelMu.Lock()
+	defer c.generationModelMu.Unlock()
+	return c.generationModels[generationID]
+}
@@ -0,0 +1,131 @@
+package langfuse
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCostCalculatorCalculateDetails(t *testing.T) {
+	calc, err := NewCostCalculator([]Model{{
+		ModelName:    "gpt-4o",
+		MatchPattern: "(?i)^gpt-4o$",
+		InputPrice:   Ptr(0.001),
+		OutputPrice:  Ptr(0.002),
+		Prices:       map[string]ModelPrice{"input_cached_tokens": {Price: 0.0005}},
+	}, {
+		ModelName:    "flat",
+		MatchPattern: "^flat$",
+		TotalPrice:   Ptr(0.01),
+	}})
+	if err != nil {
+		t.Fatalf("NewCostCalculator: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		model  string
+		usage  UsageDetails
+		want   CostDetails
+		wantOK bool
+	}{
+		{
+			name:   "input and output prices",
+			model:  "gpt-4o",
+			usage:  UsageDetails{"input": 100, "output": 50, "total": 150},
+			want:   CostDetails{"input": 0.1, "output": 0.1, "total": 0.2},
+			wantOK: true,
+		},
+		{
+			name:   "per-type price",
+			model:  "gpt-4o",
+			usage:  UsageDetails{"input_cached_tokens": 200, "audio_tokens": 10},
+			want:   CostDetails{"input_cached_tokens": 0.1, "total": 0.1},
+			wantOK: true,
+		},
+		{
+			name:   "total price only",
+			model:  "flat",
+			usage:  UsageDetails{"input": 10, "total": 30},
+			want:   CostDetails{"total": 0.3},
+			wantOK: true,
+		},
+		{
+			name:  "unknown model",
+			model: "claude",
+			usage: UsageDetails{"input": 10},
+		},
+		{
+			name:  "nothing priced",
+			model: "gpt-4o",
+			usage: UsageDetails{"audio_tokens": 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := calc.CalculateDetails(tt.model, tt.usage)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("CalculateDetails() = %v, want %v", got, tt.want)
+			}
+			for usageType, cost := range tt.want {
+				if diff := got[usageType] - cost; diff > 1e-9 || diff < -1e-9 {
+					t.Errorf("cost of %s = %v, want %v", usageType, got[usageType], cost)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateGenerationUsesModelFromCreation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	calc, err := NewCostCalculator([]Model{{
+		ModelName:    "gpt-4o",
+		MatchPattern: "^gpt-4o$",
+		InputPrice:   Ptr(0.001),
+		OutputPrice:  Ptr(0.002),
+	}})
+	if err != nil {
+		t.Fatalf("NewCostCalculator: %v", err)
+	}
+
+	client := newTestClient(t, server.URL, func(c *Config) {
+		c.FlushAt = 100
+		c.FlushInterval = time.Hour
+		c.CostCalculator = calc
+	})
+
+	id, err := client.CreateGeneration("trace", GenerationParams{Model: Ptr("gpt-4o")})
+	if err != nil {
+		t.Fatalf("CreateGeneration: %v", err)
+	}
+	if err := client.UpdateGeneration(id, GenerationParams{
+		Usage:        &Usage{Input: Ptr(100), Output: Ptr(50)},
+		UsageDetails: UsageDetails{"input": 100, "output": 50},
+	}); err != nil {
+		t.Fatalf("UpdateGeneration: %v", err)
+	}
+
+	client.batcher.mu.Lock()
+	events := append([]Event(nil), client.batcher.queue...)
+	client.batcher.mu.Unlock()
+	if len(events) != 2 {
+		t.Fatalf("queued %d events, want 2", len(events))
+	}
+
+	update := events[1].Body
+	usage, _ := update["usage"].(*Usage)
+	if usage == nil || usage.TotalCost == nil {
+		t.Errorf("update usage = %#v, want costs", update["usage"])
+	}
+	costs, _ := update["costDetails"].(CostDetails)
+	if costs["total"] == 0 {
+		t.Errorf("update costDetails = %#v, want a total", update["costDetails"])
+	}
+}
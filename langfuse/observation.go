@@ -69,6 +69,12 @@ type GenerationParams struct {
 	// Usage contains token usage information
 	Usage *Usage
 
+	// UsageDetails contains usage by type, e.g. cached input or reasoning tokens
+	UsageDetails UsageDetails
+
+	// CostDetails contains cost in USD by usage type
+	CostDetails CostDetails
+
 	// PromptName is the name of the prompt used
 	PromptName *string
 
@@ -113,6 +119,12 @@ type EmbeddingParams struct {
 
 	// EmbeddingModelParameters are parameters passed to the embedding model
 	EmbeddingModelParameters map[string]interface{}
+
+	// UsageDetails contains usage by type, e.g. input tokens
+	UsageDetails UsageDetails
+
+	// CostDetails contains cost in USD by usage type
+	CostDetails CostDetails
 }
 
 // GuardrailParams contains parameters for creating a guardrail observation
@@ -212,14 +224,26 @@ func (c *Client) CreateGeneration(traceID string, params GenerationParams) (stri
 
 	if params.Model != nil {
 		body["model"] = *params.Model
+		if c.config.CostCalculator != nil {
+			c.rememberGenerationModel(id, *params.Model)
+		}
 	}
 
 	if params.ModelParameters != nil {
 		body["modelParameters"] = params.ModelParameters
 	}
 
-	if params.Usage != nil {
-		body["usage"] = params.Usage
+	usage, costDetails := c.generationCosts(id, params)
+	if usage != nil {
+		body["usage"] = usage
+	}
+
+	if params.UsageDetails != nil {
+		body["usageDetails"] = params.UsageDetails
+	}
+
+	if costDetails != nil {
+		body["costDetails"] = costDetails
 	}
 
 	if params.PromptName != nil {
@@ -276,14 +300,26 @@ func (c *Client) UpdateGeneration(generationID string, params GenerationParams)
 
 	if params.Model != nil {
 		body["model"] = *params.Model
+		if c.config.CostCalculator != nil {
+			c.rememberGenerationModel(generationID, *params.Model)
+		}
 	}
 
 	if params.ModelParameters != nil {
 		body["modelParameters"] = params.ModelParameters
 	}
 
-	if params.Usage != nil {
-		body["usage"] = params.Usage
+	usage, costDetails := c.generationCosts(generationID, params)
+	if usage != nil {
+		body["usage"] = usage
+	}
+
+	if params.UsageDetails != nil {
+		body["usageDetails"] = params.UsageDetails
+	}
+
+	if costDetails != nil {
+		body["costDetails"] = costDetails
 	}
 
 	if params.PromptName != nil {
@@ -308,6 +344,41 @@ func (c *Client) UpdateGeneration(generationID string, params GenerationParams)
 	return c.enqueue(event)
 }
 
+// generationCosts returns the usage and cost details of a generation with
+// costs filled in by the configured CostCalculator, if any. Updates that do
+// not repeat the model are priced with the model the generation was created
+// with.
+func (c *Client) generationCosts(generationID string, params GenerationParams) (*Usage, CostDetails) {
+	calc := c.config.CostCalculator
+	if calc == nil {
+		return params.Usage, params.CostDetails
+	}
+
+	model := c.generationModel(generationID)
+	if params.Model != nil {
+		model = *params.Model
+	}
+	if model == "" {
+		return params.Usage, params.CostDetails
+	}
+
+	usage := params.Usage
+	if usage != nil {
+		if priced, ok := calc.Calculate(model, *usage); ok {
+			usage = &priced
+		}
+	}
+
+	costDetails := params.CostDetails
+	if costDetails == nil && params.UsageDetails != nil {
+		if priced, ok := calc.CalculateDetails(model, params.UsageDetails); ok {
+			costDetails = priced
+		}
+	}
+
+	return usage, costDetails
+}
+
 // observationToBody converts observation params to event body
 func observationToBody(params ObservationParams, id string) map[string]interface{} {
 	body := make(map[string]interface{})
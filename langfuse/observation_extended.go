@@ -196,6 +196,14 @@ func (c *Client) CreateEmbedding(traceID string, params EmbeddingParams) (string
 		body["modelParameters"] = params.EmbeddingModelParameters
 	}
 
+	if params.UsageDetails != nil {
+		body["usageDetails"] = params.UsageDetails
+	}
+
+	if params.CostDetails != nil {
+		body["costDetails"] = params.CostDetails
+	}
+
 	event := Event{
 		ID:        generateID(),
 		Type:      EventTypeEmbeddingCreate,
@@ -0,0 +1,55 @@
+// Package openai converts go-openai response types into Langfuse types. It is
+// a separate package so the core SDK does not depend on go-openai.
+package openai
+
+import (
+	"github.com/lvow2022/langfuse-gosdk/langfuse"
+	goopenai "github.com/sashabaranov/go-openai"
+)
+
+// Usage converts OpenAI token usage into the Langfuse input/output/total usage
+func Usage(u goopenai.Usage) *langfuse.Usage {
+	return &langfuse.Usage{
+		Input:  langfuse.Ptr(u.PromptTokens),
+		Output: langfuse.Ptr(u.CompletionTokens),
+		Total:  langfuse.Ptr(u.TotalTokens),
+		Unit:   langfuse.Ptr("TOKENS"),
+	}
+}
+
+// UsageDetails converts OpenAI token usage, including prompt_tokens_details and
+// completion_tokens_details, into Langfuse usage details. Detailed token counts
+// are reported under "input_*" and "output_*" keys and subtracted from "input"
+// and "output", so that all keys except "total" add up to the total.
+func UsageDetails(u goopenai.Usage) langfuse.UsageDetails {
+	details := langfuse.UsageDetails{
+		"total": u.TotalTokens,
+	}
+
+	input := u.PromptTokens
+	if d := u.PromptTokensDetails; d != nil {
+		input -= addDetail(details, "input_cached_tokens", d.CachedTokens)
+		input -= addDetail(details, "input_audio_tokens", d.AudioTokens)
+	}
+
+	output := u.CompletionTokens
+	if d := u.CompletionTokensDetails; d != nil {
+		output -= addDetail(details, "output_reasoning_tokens", d.ReasoningTokens)
+		output -= addDetail(details, "output_audio_tokens", d.AudioTokens)
+		output -= addDetail(details, "output_accepted_prediction_tokens", d.AcceptedPredictionTokens)
+		output -= addDetail(details, "output_rejected_prediction_tokens", d.RejectedPredictionTokens)
+	}
+
+	details["input"] = max(input, 0)
+	details["output"] = max(output, 0)
+
+	return details
+}
+
+// addDetail records a non-zero token count and returns it
+func addDetail(details langfuse.UsageDetails, key string, tokens int) int {
+	if tokens > 0 {
+		details[key] = tokens
+	}
+	return tokens
+}
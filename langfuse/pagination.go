@@ -0,0 +1,152 @@
+package langfuse
+
+import (
+	"context"
+	"iter"
+)
+
+// IterOptions controls auto-paginating iterators
+type IterOptions struct {
+	// MaxItems caps the total number of items yielded (default: 0, unlimited)
+	MaxItems int
+}
+
+// pageFetcher fetches a single page of a list endpoint
+type pageFetcher[T any] func(ctx context.Context, page int) ([]T, PaginationMeta, error)
+
+// pageResult holds the outcome of fetching one page
+type pageResult[T any] struct {
+	items []T
+	meta  PaginationMeta
+	err   error
+}
+
+// paginate returns an iterator over all items of a paginated endpoint. The next
+// page is fetched in the background while the current one is being consumed.
+// Iteration stops after the first error, which is yielded with a zero item.
+func paginate[T any](ctx context.Context, opts IterOptions, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		fetchAsync := func(page int) <-chan pageResult[T] {
+			// Buffered so the fetch goroutine never blocks if iteration stops early
+			ch := make(chan pageResult[T], 1)
+			go func() {
+				items, meta, err := fetch(ctx, page)
+				ch <- pageResult[T]{items: items, meta: meta, err: err}
+			}()
+			return ch
+		}
+
+		var zero T
+		yielded := 0
+		next := fetchAsync(1)
+
+		for page := 1; next != nil; page++ {
+			var res pageResult[T]
+			select {
+			case res = <-next:
+			case <-ctx.Done():
+				yield(zero, ctx.Err())
+				return
+			}
+
+			if res.err != nil {
+				yield(zero, res.err)
+				return
+			}
+
+			next = nil
+			hasMore := len(res.items) > 0 && page < res.meta.TotalPages
+			underCap := opts.MaxItems <= 0 || yielded+len(res.items) < opts.MaxItems
+			if hasMore && underCap {
+				next = fetchAsync(page + 1)
+			}
+
+			for _, item := range res.items {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+				if opts.MaxItems > 0 && yielded >= opts.MaxItems {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IterTraces returns an iterator over all traces matching params, fetching
+// pages of params.Limit items as needed. params.Page is ignored.
+func (c *Client) IterTraces(ctx context.Context, params ListTracesParams, opts IterOptions) iter.Seq2[TraceWithDetails, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page int) ([]TraceWithDetails, PaginationMeta, error) {
+		p := params
+		p.Page = Ptr(page)
+		resp, err := c.ListTraces(ctx, p)
+		if err != nil {
+			return nil, PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
+
+// IterObservations returns an iterator over all observations matching params,
+// fetching pages of params.Limit items as needed. params.Page is ignored.
+func (c *Client) IterObservations(ctx context.Context, params ListObservationsParams, opts IterOptions) iter.Seq2[ObservationDetails, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page int) ([]ObservationDetails, PaginationMeta, error) {
+		p := params
+		p.Page = Ptr(page)
+		resp, err := c.ListObservations(ctx, p)
+		if err != nil {
+			return nil, PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
+
+// IterSessions returns an iterator over all sessions matching params,
+// fetching pages of params.Limit items as needed. params.Page is ignored.
+func (c *Client) IterSessions(ctx context.Context, params ListSessionsParams, opts IterOptions) iter.Seq2[Session, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page int) ([]Session, PaginationMeta, error) {
+		p := params
+		p.Page = Ptr(page)
+		resp, err := c.ListSessions(ctx, p)
+		if err != nil {
+			return nil, PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
+
+// IterScores returns an iterator over all scores matching params, fetching
+// pages of params.Limit items as needed. params.Page is ignored.
+func (c *Client) IterScores(ctx context.Context, params ListScoresParams, opts IterOptions) iter.Seq2[ScoreData, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page int) ([]ScoreData, PaginationMeta, error) {
+		p := params
+		p.Page = Ptr(page)
+		resp, err := c.ListScores(ctx, p)
+		if err != nil {
+			return nil, PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
+
+// IterAnnotationQueueItems returns an iterator over all items of a queue,
+// fetching pages of params.Limit items as needed. params.Page is ignored.
+func (c *Client) IterAnnotationQueueItems(ctx context.Context, params ListAnnotationQueueItemsParams, opts IterOptions) iter.Seq2[AnnotationQueueItem, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page int) ([]AnnotationQueueItem, PaginationMeta, error) {
+		p := params
+		p.Page = Ptr(page)
+		resp, err := c.ListAnnotationQueueItems(ctx, p)
+		if err != nil {
+			return nil, PaginationMeta{}, err
+		}
+		return resp.Data, resp.Meta, nil
+	})
+}
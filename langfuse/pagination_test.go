@@ -0,0 +1,114 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+// fakePages serves items in pages of pageSize, failing on failPage if set
+func fakePages(total, pageSize, failPage int, fetched *atomic.Int32) pageFetcher[int] {
+	totalPages := (total + pageSize - 1) / pageSize
+	return func(ctx context.Context, page int) ([]int, PaginationMeta, error) {
+		fetched.Add(1)
+		if page == failPage {
+			return nil, PaginationMeta{}, errors.New("page failed")
+		}
+
+		var items []int
+		for i := (page - 1) * pageSize; i < min(page*pageSize, total); i++ {
+			items = append(items, i)
+		}
+		return items, PaginationMeta{Page: page, Limit: pageSize, TotalItems: total, TotalPages: totalPages}, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int
+		pageSize  int
+		failPage  int
+		maxItems  int
+		stopAfter int
+		wantItems int
+		wantErr   bool
+	}{
+		{name: "empty", total: 0, pageSize: 10, wantItems: 0},
+		{name: "single page", total: 5, pageSize: 10, wantItems: 5},
+		{name: "exact pages", total: 20, pageSize: 10, wantItems: 20},
+		{name: "partial last page", total: 25, pageSize: 10, wantItems: 25},
+		{name: "max items within a page", total: 25, pageSize: 10, maxItems: 15, wantItems: 15},
+		{name: "max items at a page boundary", total: 25, pageSize: 10, maxItems: 10, wantItems: 10},
+		{name: "consumer stops early", total: 25, pageSize: 10, stopAfter: 3, wantItems: 3},
+		{name: "error on a later page", total: 25, pageSize: 10, failPage: 2, wantItems: 10, wantErr: true},
+		{name: "error on the first page", total: 25, pageSize: 10, failPage: 1, wantItems: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fetched atomic.Int32
+			fetch := fakePages(tt.total, tt.pageSize, tt.failPage, &fetched)
+
+			var items []int
+			var gotErr error
+			for item, err := range paginate(context.Background(), IterOptions{MaxItems: tt.maxItems}, fetch) {
+				if err != nil {
+					gotErr = err
+					break
+				}
+				if item != len(items) {
+					t.Fatalf("item %d yielded at position %d", item, len(items))
+				}
+				items = append(items, item)
+				if tt.stopAfter > 0 && len(items) >= tt.stopAfter {
+					break
+				}
+			}
+
+			if len(items) != tt.wantItems {
+				t.Errorf("got %d items, want %d", len(items), tt.wantItems)
+			}
+			if (gotErr != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPaginateDoesNotPrefetchPastMaxItems(t *testing.T) {
+	var fetched atomic.Int32
+	fetch := fakePages(100, 10, 0, &fetched)
+
+	count := 0
+	for _, err := range paginate(context.Background(), IterOptions{MaxItems: 10}, fetch) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		count++
+	}
+
+	if count != 10 {
+		t.Errorf("got %d items, want 10", count)
+	}
+	if got := fetched.Load(); got != 1 {
+		t.Errorf("fetched %d pages, want 1", got)
+	}
+}
+
+func TestPaginateCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fetch := func(ctx context.Context, page int) ([]int, PaginationMeta, error) {
+		<-ctx.Done()
+		return nil, PaginationMeta{}, ctx.Err()
+	}
+
+	for _, err := range paginate(ctx, IterOptions{}, fetch) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error = %v, want context.Canceled", err)
+		}
+	}
+}
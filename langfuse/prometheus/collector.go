@@ -0,0 +1,92 @@
+// Package prometheus exports Langfuse SDK metrics as a Prometheus collector.
+// It is a separate module so the core SDK does not depend on client_golang.
+package prometheus
+
+import (
+	"github.com/lvow2022/langfuse-gosdk/langfuse"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "langfuse_sdk"
+
+// Collector is a prometheus.Collector for the metrics of a Langfuse client.
+// Counters only advance when the client has Config.MetricsEnabled set.
+type Collector struct {
+	client *langfuse.Client
+
+	eventsEnqueued  *prom.Desc
+	eventsFlushed   *prom.Desc
+	eventsSucceeded *prom.Desc
+	eventsFailed    *prom.Desc
+	eventsDropped   *prom.Desc
+	fieldsMasked    *prom.Desc
+	retries         *prom.Desc
+	flushes         *prom.Desc
+	queueDepth      *prom.Desc
+	flushLatency    *prom.Desc
+}
+
+// NewCollector creates a collector for the client. constLabels are added to
+// every metric, e.g. to tell several clients apart.
+func NewCollector(client *langfuse.Client, constLabels prom.Labels) *Collector {
+	desc := func(name, help string) *prom.Desc {
+		return prom.NewDesc(prom.BuildFQName(namespace, "", name), help, nil, constLabels)
+	}
+
+	return &Collector{
+		client:          client,
+		eventsEnqueued:  desc("events_enqueued_total", "Events added to the queue."),
+		eventsFlushed:   desc("events_flushed_total", "Events sent to the ingestion API."),
+		eventsSucceeded: desc("events_succeeded_total", "Events accepted by the ingestion API."),
+		eventsFailed:    desc("events_failed_total", "Events rejected by the ingestion API."),
+		eventsDropped:   desc("events_dropped_total", "Events dropped because the queue was full."),
+		fieldsMasked:    desc("fields_masked_total", "Values redacted by the configured mask."),
+		retries:         desc("retries_total", "Flushes retried after a retryable error."),
+		flushes:         desc("flushes_total", "Successful flushes."),
+		queueDepth:      desc("queue_depth", "Events waiting to be flushed."),
+		flushLatency:    desc("flush_duration_seconds", "Duration of ingestion requests."),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.eventsEnqueued
+	ch <- c.eventsFlushed
+	ch <- c.eventsSucceeded
+	ch <- c.eventsFailed
+	ch <- c.eventsDropped
+	ch <- c.fieldsMasked
+	ch <- c.retries
+	ch <- c.flushes
+	ch <- c.queueDepth
+	ch <- c.flushLatency
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	s := c.client.GetMetrics()
+
+	counter := func(desc *prom.Desc, v int64) {
+		ch <- prom.MustNewConstMetric(desc, prom.CounterValue, float64(v))
+	}
+	counter(c.eventsEnqueued, s.EventsEnqueued)
+	counter(c.eventsFlushed, s.EventsFlushed)
+	counter(c.eventsSucceeded, s.EventsSucceeded)
+	counter(c.eventsFailed, s.EventsFailed)
+	counter(c.eventsDropped, s.EventsDropped)
+	counter(c.fieldsMasked, s.FieldsMasked)
+	counter(c.retries, s.RetryCount)
+	counter(c.flushes, s.FlushCount)
+
+	ch <- prom.MustNewConstMetric(c.queueDepth, prom.GaugeValue, float64(s.QueueDepth))
+	ch <- constHistogram(c.flushLatency, s.FlushLatency)
+}
+
+// constHistogram converts a histogram snapshot into a Prometheus histogram
+func constHistogram(desc *prom.Desc, h langfuse.HistogramSnapshot) prom.Metric {
+	buckets := make(map[float64]uint64, len(h.Buckets))
+	for _, b := range h.Buckets {
+		buckets[b.UpperBound] = b.Count
+	}
+	return prom.MustNewConstHistogram(desc, h.Count, h.Sum, buckets)
+}
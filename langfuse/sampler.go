@@ -0,0 +1,293 @@
+package langfuse
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+)
+
+// maxSampledOutTraces is the number of sampled-out traces remembered so that
+// their observations and scores are dropped as well. The oldest decisions are
+// forgotten first.
+const maxSampledOutTraces = 10000
+
+// SamplingMode controls what happens to traces the Sampler rejects
+type SamplingMode string
+
+const (
+	// SamplingModeHead drops rejected traces with all their observations and scores
+	SamplingModeHead SamplingMode = "head"
+
+	// SamplingModeErrorBiased holds back the events of rejected traces and sends
+	// them after all if one of their observations has level ERROR
+	SamplingModeErrorBiased SamplingMode = "error_biased"
+)
+
+// Sampler decides whether a new trace is sent to Langfuse. It is called once
+// per trace in CreateTrace; all observations and scores of the trace follow
+// the decision.
+type Sampler interface {
+	ShouldSample(traceID string, params TraceParams) bool
+}
+
+// SamplerFunc adapts a function to the Sampler interface
+type SamplerFunc func(traceID string, params TraceParams) bool
+
+// ShouldSample implements Sampler
+func (f SamplerFunc) ShouldSample(traceID string, params TraceParams) bool {
+	return f(traceID, params)
+}
+
+// RatioSampler keeps the given fraction (0 to 1) of traces. The decision is
+// derived from the trace ID, so services sharing a trace ID agree on it.
+func RatioSampler(ratio float64) Sampler {
+	if ratio >= 1 {
+		return SamplerFunc(func(string, TraceParams) bool { return true })
+	}
+	if ratio <= 0 {
+		return SamplerFunc(func(string, TraceParams) bool { return false })
+	}
+
+	threshold := uint64(ratio * math.MaxUint64)
+	return SamplerFunc(func(traceID string, _ TraceParams) bool {
+		sum := sha256.Sum256([]byte(traceID))
+		return binary.BigEndian.Uint64(sum[:8]) < threshold
+	})
+}
+
+// rateLimitSampler is a token bucket refilled at a fixed rate
+type rateLimitSampler struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	lastFill time.Time
+}
+
+// RateLimitSampler keeps at most perSecond traces per second, allowing bursts
+// of up to one second's worth of traces
+func RateLimitSampler(perSecond float64) Sampler {
+	burst := math.Max(perSecond, 1)
+	return &rateLimitSampler{
+		rate:     perSecond,
+		burst:    burst,
+		tokens:   burst,
+		lastFill: time.Now(),
+	}
+}
+
+// ShouldSample implements Sampler
+func (s *rateLimitSampler) ShouldSample(string, TraceParams) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.tokens = math.Min(s.burst, s.tokens+now.Sub(s.lastFill).Seconds()*s.rate)
+	s.lastFill = now
+
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+// SamplingRule applies a sampler to the traces it matches. Empty fields match
+// any trace.
+type SamplingRule struct {
+	// Name matches the trace name
+	Name string
+
+	// UserID matches the trace user
+	UserID string
+
+	// Tag matches traces that have this tag
+	Tag string
+
+	// Sampler decides for matching traces
+	Sampler Sampler
+}
+
+// matches reports whether the rule applies to a trace
+func (r SamplingRule) matches(params TraceParams) bool {
+	if r.Name != "" && (params.Name == nil || *params.Name != r.Name) {
+		return false
+	}
+	if r.UserID != "" && (params.UserID == nil || *params.UserID != r.UserID) {
+		return false
+	}
+	if r.Tag != "" {
+		for _, tag := range params.Tags {
+			if tag == r.Tag {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// RuleSampler uses the sampler of the first rule matching a trace, or fallback
+// if none matches. A nil fallback keeps unmatched traces.
+func RuleSampler(rules []SamplingRule, fallback Sampler) Sampler {
+	return SamplerFunc(func(traceID string, params TraceParams) bool {
+		for _, rule := range rules {
+			if rule.matches(params) {
+				return rule.Sampler.ShouldSample(traceID, params)
+			}
+		}
+		if fallback == nil {
+			return true
+		}
+		return fallback.ShouldSample(traceID, params)
+	})
+}
+
+// sampledOutTrace tracks a trace the sampler rejected
+type sampledOutTrace struct {
+	// observations are the IDs of observations seen for the trace, so updates
+	// without a trace ID can be matched
+	observations []string
+
+	// events are held back in error-biased mode until an error shows up
+	events []Event
+
+	// overflowed is set once held back events had to be discarded
+	overflowed bool
+}
+
+// traceSampler applies sampling decisions to all events of a trace
+type traceSampler struct {
+	sampler   Sampler
+	mode      SamplingMode
+	maxBuffer int
+
+	mu           sync.Mutex
+	traces       map[string]*sampledOutTrace
+	order        []string
+	observations map[string]string
+	buffered     int
+}
+
+// newTraceSampler creates a trace sampler from the client config
+func newTraceSampler(config *Config) *traceSampler {
+	return &traceSampler{
+		sampler:      config.Sampler,
+		mode:         config.SamplingMode,
+		maxBuffer:    config.MaxQueueSize,
+		traces:       make(map[string]*sampledOutTrace),
+		observations: make(map[string]string),
+	}
+}
+
+// decide asks the sampler about a new trace and remembers rejected ones
+func (s *traceSampler) decide(traceID string, params TraceParams) bool {
+	if s.sampler.ShouldSample(traceID, params) {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.traces[traceID]; ok {
+		return false
+	}
+
+	s.traces[traceID] = &sampledOutTrace{}
+	s.order = append(s.order, traceID)
+	if len(s.order) > maxSampledOutTraces {
+		s.forget(s.order[0])
+		s.order = s.order[1:]
+	}
+
+	return false
+}
+
+// filter returns the events to send for an event: none if its trace was
+// sampled out, or the held back events of the trace once it has an error.
+// Scores that reference only an observation are dropped only if the
+// observation belongs to a sampled-out trace.
+func (s *traceSampler) filter(event Event) []Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var traceID, observationID string
+	switch event.Type {
+	case EventTypeTraceCreate:
+		traceID, _ = event.Body["id"].(string)
+	case EventTypeScoreCreate:
+		traceID, _ = event.Body["traceId"].(string)
+		if scoredID, _ := event.Body["observationId"].(string); traceID == "" && scoredID != "" {
+			traceID = s.observations[scoredID]
+		}
+	case EventTypeSdkLog:
+		return []Event{event}
+	default:
+		observationID, _ = event.Body["id"].(string)
+		traceID, _ = event.Body["traceId"].(string)
+		if traceID == "" {
+			traceID = s.observations[observationID]
+		}
+	}
+
+	trace, ok := s.traces[traceID]
+	if !ok {
+		return []Event{event}
+	}
+
+	if observationID != "" {
+		if _, known := s.observations[observationID]; !known {
+			s.observations[observationID] = traceID
+			trace.observations = append(trace.observations, observationID)
+		}
+	}
+
+	if s.mode != SamplingModeErrorBiased || trace.overflowed {
+		return nil
+	}
+
+	if level, _ := event.Body["level"].(string); level == string(LevelError) {
+		events := append(trace.events, event)
+		s.forget(traceID)
+		return events
+	}
+
+	if s.buffered >= s.maxBuffer {
+		s.discardOldest()
+	}
+	trace.events = append(trace.events, event)
+	s.buffered++
+
+	return nil
+}
+
+// discardOldest frees the held back events of the oldest trace that has any.
+// That trace stays sampled out, even if it reports an error later.
+func (s *traceSampler) discardOldest() {
+	for _, traceID := range s.order {
+		trace, ok := s.traces[traceID]
+		if !ok || len(trace.events) == 0 {
+			continue
+		}
+		s.buffered -= len(trace.events)
+		trace.events = nil
+		trace.overflowed = true
+		return
+	}
+}
+
+// forget removes everything known about a sampled-out trace. Its entry in
+// order is skipped once it reaches the front.
+func (s *traceSampler) forget(traceID string) {
+	trace, ok := s.traces[traceID]
+	if !ok {
+		return
+	}
+	for _, id := range trace.observations {
+		delete(s.observations, id)
+	}
+	s.buffered -= len(trace.events)
+	delete(s.traces, traceID)
+}
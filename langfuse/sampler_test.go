@@ -0,0 +1,195 @@
+package langfuse
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRatioSampler(t *testing.T) {
+	tests := []struct {
+		ratio    float64
+		min, max int
+	}{
+		{0, 0, 0},
+		{-1, 0, 0},
+		{1, 1000, 1000},
+		{2, 1000, 1000},
+		{0.5, 400, 600},
+		{0.1, 50, 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.ratio), func(t *testing.T) {
+			sampler := RatioSampler(tt.ratio)
+			kept := 0
+			for i := 0; i < 1000; i++ {
+				traceID := fmt.Sprintf("trace-%d", i)
+				decision := sampler.ShouldSample(traceID, TraceParams{})
+				if sampler.ShouldSample(traceID, TraceParams{}) != decision {
+					t.Fatalf("decision for %s is not deterministic", traceID)
+				}
+				if decision {
+					kept++
+				}
+			}
+			if kept < tt.min || kept > tt.max {
+				t.Errorf("kept %d of 1000 traces, want between %d and %d", kept, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestRateLimitSampler(t *testing.T) {
+	sampler := RateLimitSampler(5)
+
+	kept := 0
+	for i := 0; i < 20; i++ {
+		if sampler.ShouldSample("", TraceParams{}) {
+			kept++
+		}
+	}
+	if kept != 5 {
+		t.Errorf("kept %d traces in a burst, want 5", kept)
+	}
+}
+
+func TestRuleSampler(t *testing.T) {
+	keep := SamplerFunc(func(string, TraceParams) bool { return true })
+	drop := SamplerFunc(func(string, TraceParams) bool { return false })
+
+	sampler := RuleSampler([]SamplingRule{
+		{Name: "health-check", Sampler: drop},
+		{UserID: "vip", Sampler: keep},
+		{Tag: "debug", Sampler: drop},
+	}, drop)
+
+	tests := []struct {
+		name   string
+		params TraceParams
+		want   bool
+	}{
+		{"name rule", TraceParams{Name: Ptr("health-check"), UserID: Ptr("vip")}, false},
+		{"user rule", TraceParams{Name: Ptr("chat"), UserID: Ptr("vip")}, true},
+		{"tag rule", TraceParams{Tags: []string{"prod", "debug"}}, false},
+		{"fallback", TraceParams{Name: Ptr("chat")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sampler.ShouldSample("trace", tt.params); got != tt.want {
+				t.Errorf("ShouldSample() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !RuleSampler(nil, nil).ShouldSample("trace", TraceParams{}) {
+		t.Error("RuleSampler without rules and fallback should keep traces")
+	}
+}
+
+func TestTraceSamplerFilter(t *testing.T) {
+	keepTrace := func(traceID string) Sampler {
+		return SamplerFunc(func(id string, _ TraceParams) bool { return id == traceID })
+	}
+
+	trace := func(id string) Event {
+		return Event{Type: EventTypeTraceCreate, Body: map[string]interface{}{"id": id}}
+	}
+	span := func(id, traceID string) Event {
+		return Event{Type: EventTypeSpanCreate, Body: map[string]interface{}{"id": id, "traceId": traceID}}
+	}
+	spanUpdate := func(id string, level ObservationLevel) Event {
+		return Event{Type: EventTypeSpanUpdate, Body: map[string]interface{}{"id": id, "level": string(level)}}
+	}
+	score := func(body map[string]interface{}) Event {
+		return Event{Type: EventTypeScoreCreate, Body: body}
+	}
+
+	tests := []struct {
+		name   string
+		mode   SamplingMode
+		events []Event
+		want   []int
+	}{
+		{
+			name:   "kept trace",
+			mode:   SamplingModeHead,
+			events: []Event{trace("kept"), span("s1", "kept"), spanUpdate("s1", LevelDefault)},
+			want:   []int{1, 1, 1},
+		},
+		{
+			name:   "sampled-out trace",
+			mode:   SamplingModeHead,
+			events: []Event{trace("dropped"), span("s1", "dropped"), spanUpdate("s1", LevelDefault)},
+			want:   []int{0, 0, 0},
+		},
+		{
+			name: "scores follow their trace",
+			mode: SamplingModeHead,
+			events: []Event{
+				trace("kept"), trace("dropped"),
+				score(map[string]interface{}{"traceId": "kept"}),
+				score(map[string]interface{}{"traceId": "dropped"}),
+			},
+			want: []int{1, 0, 1, 0},
+		},
+		{
+			name: "observation-only scores follow the observation's trace",
+			mode: SamplingModeHead,
+			events: []Event{
+				trace("kept"), span("s1", "kept"),
+				trace("dropped"), span("s2", "dropped"),
+				score(map[string]interface{}{"observationId": "s1"}),
+				score(map[string]interface{}{"observationId": "s2"}),
+			},
+			want: []int{1, 1, 0, 0, 1, 0},
+		},
+		{
+			name:   "observation-only score for an unknown observation",
+			mode:   SamplingModeHead,
+			events: []Event{score(map[string]interface{}{"observationId": "unknown"})},
+			want:   []int{1},
+		},
+		{
+			name:   "session score",
+			mode:   SamplingModeHead,
+			events: []Event{score(map[string]interface{}{"sessionId": "session"})},
+			want:   []int{1},
+		},
+		{
+			name: "error-biased releases held back events on error",
+			mode: SamplingModeErrorBiased,
+			events: []Event{
+				trace("dropped"), span("s1", "dropped"),
+				spanUpdate("s1", LevelError),
+				score(map[string]interface{}{"observationId": "s1"}),
+			},
+			want: []int{0, 0, 3, 1},
+		},
+		{
+			name:   "sdk logs pass through",
+			mode:   SamplingModeHead,
+			events: []Event{{Type: EventTypeSdkLog, Body: map[string]interface{}{"log": "message"}}},
+			want:   []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Sampler = keepTrace("kept")
+			config.SamplingMode = tt.mode
+			sampler := newTraceSampler(config)
+
+			for i, event := range tt.events {
+				// CreateTrace decides before the trace event is filtered
+				if event.Type == EventTypeTraceCreate {
+					sampler.decide(event.Body["id"].(string), TraceParams{})
+				}
+				if got := len(sampler.filter(event)); got != tt.want[i] {
+					t.Errorf("event %d (%s): got %d events, want %d", i, event.Type, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -1,9 +1,17 @@
 package langfuse
 
 import (
+	"fmt"
 	"time"
 )
 
+// Score data types supported by Langfuse
+const (
+	ScoreDataTypeNumeric     = "NUMERIC"
+	ScoreDataTypeCategorical = "CATEGORICAL"
+	ScoreDataTypeBoolean     = "BOOLEAN"
+)
+
 // ScoreParams contains parameters for creating a score
 type ScoreParams struct {
 	// ID is the unique identifier (auto-generated if not provided)
@@ -18,9 +26,12 @@ type ScoreParams struct {
 	// Name is the name/identifier of the score (required)
 	Name string
 
-	// Value is the numeric score value (required)
+	// Value is the score value for NUMERIC scores, or 0/1 for BOOLEAN scores
 	Value float64
 
+	// StringValue is the score value for CATEGORICAL scores
+	StringValue *string
+
 	// Comment is an optional comment about the score
 	Comment *string
 
@@ -31,8 +42,85 @@ type ScoreParams struct {
 	ConfigID *string
 }
 
+// NumericScore returns ScoreParams for a NUMERIC score
+func NumericScore(name string, value float64) ScoreParams {
+	return ScoreParams{
+		Name:     name,
+		Value:    value,
+		DataType: ptr(ScoreDataTypeNumeric),
+	}
+}
+
+// CategoricalScore returns ScoreParams for a CATEGORICAL score with a string label
+func CategoricalScore(name string, value string) ScoreParams {
+	return ScoreParams{
+		Name:        name,
+		StringValue: &value,
+		DataType:    ptr(ScoreDataTypeCategorical),
+	}
+}
+
+// BooleanScore returns ScoreParams for a BOOLEAN score
+func BooleanScore(name string, value bool) ScoreParams {
+	params := ScoreParams{
+		Name:     name,
+		DataType: ptr(ScoreDataTypeBoolean),
+	}
+	if value {
+		params.Value = 1
+	}
+	return params
+}
+
+// dataType returns the effective data type of the score
+func (p ScoreParams) dataType() string {
+	if p.DataType != nil {
+		return *p.DataType
+	}
+	return ScoreDataTypeNumeric
+}
+
+// Validate checks that the score value matches its data type
+func (p ScoreParams) Validate() error {
+	if p.Name == "" {
+		return &ScoreValidationError{Message: "score name is required"}
+	}
+
+	switch p.dataType() {
+	case ScoreDataTypeNumeric:
+		if p.StringValue != nil {
+			return &ScoreValidationError{Name: p.Name, Message: "NUMERIC score must not have a string value"}
+		}
+	case ScoreDataTypeCategorical:
+		if p.StringValue == nil {
+			return &ScoreValidationError{Name: p.Name, Message: "CATEGORICAL score requires a string value"}
+		}
+	case ScoreDataTypeBoolean:
+		if p.StringValue != nil {
+			return &ScoreValidationError{Name: p.Name, Message: "BOOLEAN score must not have a string value"}
+		}
+		if p.Value != 0 && p.Value != 1 {
+			return &ScoreValidationError{Name: p.Name, Message: fmt.Sprintf("BOOLEAN score value must be 0 or 1, got %v", p.Value)}
+		}
+	default:
+		return &ScoreValidationError{Name: p.Name, Message: fmt.Sprintf("unknown data type %q", p.dataType())}
+	}
+
+	return nil
+}
+
 // CreateScore creates a new score for a trace or observation
 func (c *Client) CreateScore(params ScoreParams) (string, error) {
+	if err := params.Validate(); err != nil {
+		return "", err
+	}
+
+	if c.config.ValidateScores {
+		if err := c.validateScoreAgainstConfig(params); err != nil {
+			return "", err
+		}
+	}
+
 	id := generateID()
 	if params.ID != nil {
 		id = *params.ID
@@ -66,7 +154,13 @@ func scoreToBody(params ScoreParams, id string) map[string]interface{} {
 
 	body["id"] = id
 	body["name"] = params.Name
-	body["value"] = params.Value
+	body["dataType"] = params.dataType()
+
+	if params.dataType() == ScoreDataTypeCategorical && params.StringValue != nil {
+		body["value"] = *params.StringValue
+	} else {
+		body["value"] = params.Value
+	}
 
 	if params.TraceID != nil {
 		body["traceId"] = *params.TraceID
@@ -80,15 +174,27 @@ func scoreToBody(params ScoreParams, id string) map[string]interface{} {
 		body["comment"] = *params.Comment
 	}
 
-	if params.DataType != nil {
-		body["dataType"] = *params.DataType
-	} else {
-		body["dataType"] = "NUMERIC"
-	}
-
 	if params.ConfigID != nil {
 		body["configId"] = *params.ConfigID
 	}
 
 	return body
 }
+
+// ScoreValidationError is returned when a score is rejected before being queued
+type ScoreValidationError struct {
+	Name     string
+	ConfigID string
+	Message  string
+}
+
+func (e *ScoreValidationError) Error() string {
+	msg := "score validation error: "
+	if e.Name != "" {
+		msg += e.Name + ": "
+	}
+	if e.ConfigID != "" {
+		msg += "config " + e.ConfigID + ": "
+	}
+	return msg + e.Message
+}
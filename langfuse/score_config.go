@@ -0,0 +1,305 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// scoreConfigRetryInterval is how long a missing score config or a failed
+// lookup is cached before the config is fetched again
+const scoreConfigRetryInterval = time.Minute
+
+// ScoreConfigCategory is an allowed label of a CATEGORICAL score config
+type ScoreConfigCategory struct {
+	Label string  `json:"label"`
+	Value float64 `json:"value"`
+}
+
+// ScoreConfig defines the shape a score must have (data type, range or categories)
+type ScoreConfig struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	DataType    string                `json:"dataType"`
+	IsArchived  bool                  `json:"isArchived"`
+	MinValue    *float64              `json:"minValue,omitempty"`
+	MaxValue    *float64              `json:"maxValue,omitempty"`
+	Categories  []ScoreConfigCategory `json:"categories,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	ProjectID   string                `json:"projectId"`
+	CreatedAt   string                `json:"createdAt"`
+	UpdatedAt   string                `json:"updatedAt"`
+}
+
+// PaginatedScoreConfigs represents paginated score config list response
+type PaginatedScoreConfigs struct {
+	Data []ScoreConfig  `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// CreateScoreConfigParams represents parameters for creating a score config
+type CreateScoreConfigParams struct {
+	Name        string                `json:"name"`
+	DataType    string                `json:"dataType"`
+	MinValue    *float64              `json:"minValue,omitempty"`
+	MaxValue    *float64              `json:"maxValue,omitempty"`
+	Categories  []ScoreConfigCategory `json:"categories,omitempty"`
+	Description *string               `json:"description,omitempty"`
+}
+
+// UpdateScoreConfigParams represents parameters for updating a score config.
+// Only non-nil fields are changed.
+type UpdateScoreConfigParams struct {
+	ConfigID    string                `json:"-"`
+	Name        *string               `json:"name,omitempty"`
+	IsArchived  *bool                 `json:"isArchived,omitempty"`
+	MinValue    *float64              `json:"minValue,omitempty"`
+	MaxValue    *float64              `json:"maxValue,omitempty"`
+	Categories  []ScoreConfigCategory `json:"categories,omitempty"`
+	Description *string               `json:"description,omitempty"`
+}
+
+// GetScoreConfigParams represents parameters for fetching a score config
+type GetScoreConfigParams struct {
+	ConfigID string
+}
+
+// ListScoreConfigsParams represents parameters for listing score configs
+type ListScoreConfigsParams struct {
+	Page  *int
+	Limit *int
+}
+
+// CreateScoreConfig creates a new score config
+func (c *Client) CreateScoreConfig(ctx context.Context, params CreateScoreConfigParams) (*ScoreConfig, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.Name == "" {
+		return nil, fmt.Errorf("score config name is required")
+	}
+	if params.DataType == "" {
+		return nil, fmt.Errorf("score config data type is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/score-configs", c.config.BaseURL)
+
+	config, err := c.sendJSON(ctx, http.MethodPost, endpoint, params, &ScoreConfig{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create score config: %w", err)
+	}
+
+	c.cacheScoreConfig(config.(*ScoreConfig))
+	return config.(*ScoreConfig), nil
+}
+
+// UpdateScoreConfig updates an existing score config, e.g. to archive it
+func (c *Client) UpdateScoreConfig(ctx context.Context, params UpdateScoreConfigParams) (*ScoreConfig, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.ConfigID == "" {
+		return nil, fmt.Errorf("configID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/score-configs/%s", c.config.BaseURL, url.PathEscape(params.ConfigID))
+
+	config, err := c.sendJSON(ctx, http.MethodPatch, endpoint, params, &ScoreConfig{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update score config: %w", err)
+	}
+
+	c.cacheScoreConfig(config.(*ScoreConfig))
+	return config.(*ScoreConfig), nil
+}
+
+// GetScoreConfig retrieves a score config by ID
+func (c *Client) GetScoreConfig(ctx context.Context, params GetScoreConfigParams) (*ScoreConfig, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.ConfigID == "" {
+		return nil, fmt.Errorf("configID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/score-configs/%s", c.config.BaseURL, url.PathEscape(params.ConfigID))
+
+	config, err := c.fetchJSON(ctx, endpoint, &ScoreConfig{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get score config: %w", err)
+	}
+
+	c.cacheScoreConfig(config.(*ScoreConfig))
+	return config.(*ScoreConfig), nil
+}
+
+// ListScoreConfigs retrieves a paginated list of score configs
+func (c *Client) ListScoreConfigs(ctx context.Context, params ListScoreConfigsParams) (*PaginatedScoreConfigs, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	baseURL := fmt.Sprintf("%s/api/public/score-configs", c.config.BaseURL)
+	queryParams := url.Values{}
+
+	if params.Page != nil {
+		queryParams.Set("page", strconv.Itoa(*params.Page))
+	}
+	if params.Limit != nil {
+		queryParams.Set("limit", strconv.Itoa(*params.Limit))
+	}
+
+	fullURL := baseURL
+	if len(queryParams) > 0 {
+		fullURL = baseURL + "?" + queryParams.Encode()
+	}
+
+	configs, err := c.fetchJSON(ctx, fullURL, &PaginatedScoreConfigs{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list score configs: %w", err)
+	}
+
+	result := configs.(*PaginatedScoreConfigs)
+	for i := range result.Data {
+		c.cacheScoreConfig(&result.Data[i])
+	}
+
+	return result, nil
+}
+
+// RefreshScoreConfigs loads all score configs into the validation cache
+func (c *Client) RefreshScoreConfigs(ctx context.Context) error {
+	for page := 1; ; page++ {
+		resp, err := c.ListScoreConfigs(ctx, ListScoreConfigsParams{Page: Ptr(page)})
+		if err != nil {
+			return err
+		}
+		if len(resp.Data) == 0 || page >= resp.Meta.TotalPages {
+			return nil
+		}
+	}
+}
+
+// scoreConfigEntry is a cached score config lookup. Failed lookups keep err
+// and expire so they are retried later.
+type scoreConfigEntry struct {
+	config  *ScoreConfig
+	err     error
+	expires time.Time
+}
+
+// cacheScoreConfig stores a score config for client-side validation
+func (c *Client) cacheScoreConfig(config *ScoreConfig) {
+	c.storeScoreConfigEntry(config.ID, scoreConfigEntry{config: config})
+}
+
+// storeScoreConfigEntry stores a score config lookup result
+func (c *Client) storeScoreConfigEntry(configID string, entry scoreConfigEntry) {
+	c.scoreConfigMu.Lock()
+	defer c.scoreConfigMu.Unlock()
+
+	if c.scoreConfigs == nil {
+		c.scoreConfigs = make(map[string]scoreConfigEntry)
+	}
+	c.scoreConfigs[configID] = entry
+}
+
+// scoreConfig returns the cached score config, fetching it on a cache miss.
+// Failed lookups are cached for scoreConfigRetryInterval.
+func (c *Client) scoreConfig(configID string) (*ScoreConfig, error) {
+	c.scoreConfigMu.RLock()
+	entry, ok := c.scoreConfigs[configID]
+	c.scoreConfigMu.RUnlock()
+	if ok && (entry.expires.IsZero() || time.Now().Before(entry.expires)) {
+		return entry.config, entry.err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+	defer cancel()
+
+	config, err := c.GetScoreConfig(ctx, GetScoreConfigParams{ConfigID: configID})
+	if err != nil {
+		c.storeScoreConfigEntry(configID, scoreConfigEntry{
+			err:     err,
+			expires: time.Now().Add(scoreConfigRetryInterval),
+		})
+	}
+	return config, err
+}
+
+// validateScoreAgainstConfig checks a score against its cached score config.
+// A config that does not exist is a validation error; if the config cannot be
+// fetched for another reason the score is not validated.
+func (c *Client) validateScoreAgainstConfig(params ScoreParams) error {
+	if params.ConfigID == nil || !c.config.Enabled {
+		return nil
+	}
+
+	config, err := c.scoreConfig(*params.ConfigID)
+	if err != nil {
+		var httpErr *LangfuseError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return &ScoreValidationError{
+				Name:     params.Name,
+				ConfigID: *params.ConfigID,
+				Message:  "score config not found",
+			}
+		}
+
+		c.logger.Warn("skipping score validation, score config unavailable",
+			slog.String("config_id", *params.ConfigID),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	return config.Validate(params)
+}
+
+// Validate checks that a score fits this config
+func (sc *ScoreConfig) Validate(params ScoreParams) error {
+	newErr := func(format string, args ...interface{}) error {
+		return &ScoreValidationError{
+			Name:     params.Name,
+			ConfigID: sc.ID,
+			Message:  fmt.Sprintf(format, args...),
+		}
+	}
+
+	if sc.IsArchived {
+		return newErr("score config %q is archived", sc.Name)
+	}
+	if params.dataType() != sc.DataType {
+		return newErr("data type %s does not match config data type %s", params.dataType(), sc.DataType)
+	}
+
+	switch sc.DataType {
+	case ScoreDataTypeNumeric:
+		if sc.MinValue != nil && params.Value < *sc.MinValue {
+			return newErr("value %v is below minimum %v", params.Value, *sc.MinValue)
+		}
+		if sc.MaxValue != nil && params.Value > *sc.MaxValue {
+			return newErr("value %v is above maximum %v", params.Value, *sc.MaxValue)
+		}
+	case ScoreDataTypeCategorical:
+		if params.StringValue == nil {
+			return newErr("categorical score requires a string value")
+		}
+		for _, category := range sc.Categories {
+			if category.Label == *params.StringValue {
+				return nil
+			}
+		}
+		return newErr("value %q is not an allowed category", *params.StringValue)
+	}
+
+	return nil
+}
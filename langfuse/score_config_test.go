@@ -0,0 +1,121 @@
+package langfuse
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// newTestClient returns a client that sends requests to baseURL
+func newTestClient(t *testing.T, baseURL string, configure func(*Config)) *Client {
+	t.Helper()
+
+	config := DefaultConfig()
+	config.PublicKey = "pk-lf-test"
+	config.SecretKey = "sk-lf-test"
+	config.BaseURL = baseURL
+	if configure != nil {
+		configure(config)
+	}
+
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestScoreConfigValidate(t *testing.T) {
+	numeric := &ScoreConfig{
+		ID:       "numeric",
+		Name:     "accuracy",
+		DataType: ScoreDataTypeNumeric,
+		MinValue: Ptr(0.0),
+		MaxValue: Ptr(1.0),
+	}
+	categorical := &ScoreConfig{
+		ID:       "categorical",
+		Name:     "sentiment",
+		DataType: ScoreDataTypeCategorical,
+		Categories: []ScoreConfigCategory{
+			{Label: "positive", Value: 1},
+			{Label: "negative", Value: 0},
+		},
+	}
+	archived := &ScoreConfig{ID: "archived", Name: "old", DataType: ScoreDataTypeNumeric, IsArchived: true}
+
+	tests := []struct {
+		name    string
+		config  *ScoreConfig
+		params  ScoreParams
+		wantErr bool
+	}{
+		{"numeric within bounds", numeric, ScoreParams{Name: "accuracy", Value: 0.5}, false},
+		{"numeric at minimum", numeric, ScoreParams{Name: "accuracy", Value: 0}, false},
+		{"numeric at maximum", numeric, ScoreParams{Name: "accuracy", Value: 1}, false},
+		{"numeric below minimum", numeric, ScoreParams{Name: "accuracy", Value: -0.1}, true},
+		{"numeric above maximum", numeric, ScoreParams{Name: "accuracy", Value: 1.1}, true},
+		{"numeric without bounds", &ScoreConfig{ID: "open", DataType: ScoreDataTypeNumeric}, ScoreParams{Name: "latency", Value: 1e6}, false},
+		{"data type mismatch", numeric, ScoreParams{Name: "accuracy", DataType: Ptr(ScoreDataTypeBoolean), Value: 1}, true},
+		{"allowed category", categorical, ScoreParams{Name: "sentiment", DataType: Ptr(ScoreDataTypeCategorical), StringValue: Ptr("positive")}, false},
+		{"unknown category", categorical, ScoreParams{Name: "sentiment", DataType: Ptr(ScoreDataTypeCategorical), StringValue: Ptr("neutral")}, true},
+		{"category without string value", categorical, ScoreParams{Name: "sentiment", DataType: Ptr(ScoreDataTypeCategorical)}, true},
+		{"archived config", archived, ScoreParams{Name: "old", Value: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate(tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var validationErr *ScoreValidationError
+			if err != nil && !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() error = %T, want *ScoreValidationError", err)
+			}
+		})
+	}
+}
+
+func TestValidateScoreAgainstConfigCachesFailedLookups(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"missing config is a validation error", http.StatusNotFound, true},
+		{"unavailable config skips validation", http.StatusInternalServerError, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := newTestClient(t, server.URL, nil)
+			params := ScoreParams{Name: "accuracy", Value: 1, ConfigID: Ptr("config-1")}
+
+			for i := 0; i < 3; i++ {
+				err := client.validateScoreAgainstConfig(params)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("validateScoreAgainstConfig() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				var validationErr *ScoreValidationError
+				if err != nil && !errors.As(err, &validationErr) {
+					t.Fatalf("validateScoreAgainstConfig() error = %T, want *ScoreValidationError", err)
+				}
+			}
+
+			if got := requests.Load(); got != 1 {
+				t.Errorf("score config fetched %d times, want 1", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package langfuse
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestScoreDataUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            string
+		wantValue       float64
+		wantStringValue *string
+		wantErr         bool
+	}{
+		{"numeric", `{"name":"accuracy","value":0.75,"dataType":"NUMERIC"}`, 0.75, nil, false},
+		{"boolean", `{"name":"correct","value":1,"stringValue":"True","dataType":"BOOLEAN"}`, 1, Ptr("True"), false},
+		{"categorical with string value", `{"name":"tone","value":"polite","dataType":"CATEGORICAL"}`, 0, Ptr("polite"), false},
+		{"categorical with both values", `{"name":"tone","value":"polite","stringValue":"friendly","dataType":"CATEGORICAL"}`, 0, Ptr("friendly"), false},
+		{"null value", `{"name":"tone","value":null,"stringValue":"polite","dataType":"CATEGORICAL"}`, 0, Ptr("polite"), false},
+		{"missing value", `{"name":"tone"}`, 0, nil, false},
+		{"invalid value", `{"name":"tone","value":{}}`, 0, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var score ScoreData
+			err := json.Unmarshal([]byte(tt.data), &score)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if score.Value != tt.wantValue {
+				t.Errorf("Value = %v, want %v", score.Value, tt.wantValue)
+			}
+			switch {
+			case tt.wantStringValue == nil && score.StringValue != nil:
+				t.Errorf("StringValue = %q, want nil", *score.StringValue)
+			case tt.wantStringValue != nil && (score.StringValue == nil || *score.StringValue != *tt.wantStringValue):
+				t.Errorf("StringValue = %v, want %q", score.StringValue, *tt.wantStringValue)
+			}
+		})
+	}
+}
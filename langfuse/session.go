@@ -0,0 +1,124 @@
+package langfuse
+
+import (
+	"time"
+)
+
+// SessionSummary holds aggregates computed client-side from a session's traces
+type SessionSummary struct {
+	SessionID        string
+	TraceCount       int
+	ObservationCount int
+
+	// InputTokens, OutputTokens and TotalTokens are summed from observation usage
+	InputTokens  int
+	OutputTokens int
+	TotalTokens  int
+
+	// TotalCost is summed from observation usage costs
+	TotalCost float64
+
+	// StartTime and EndTime span the earliest and latest timestamps in the session
+	StartTime time.Time
+	EndTime   time.Time
+	Duration  time.Duration
+
+	// ScoresByName groups all trace and observation scores by score name
+	ScoresByName map[string][]ScoreData
+}
+
+// MeanScore returns the mean value of the numeric or boolean scores with the
+// given name, and false if there are none
+func (s SessionSummary) MeanScore(name string) (float64, bool) {
+	var sum float64
+	var count int
+	for _, score := range s.ScoresByName[name] {
+		if score.DataType == ScoreDataTypeCategorical {
+			continue
+		}
+		sum += score.Value
+		count++
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
+
+// Summary computes trace count, token usage, cost, duration and scores for the session
+func (s *SessionWithTraces) Summary() SessionSummary {
+	summary := SessionSummary{
+		SessionID:    s.ID,
+		TraceCount:   len(s.Traces),
+		ScoresByName: make(map[string][]ScoreData),
+	}
+
+	observeTime := func(t time.Time) {
+		if t.IsZero() {
+			return
+		}
+		if summary.StartTime.IsZero() || t.Before(summary.StartTime) {
+			summary.StartTime = t
+		}
+		if t.After(summary.EndTime) {
+			summary.EndTime = t
+		}
+	}
+
+	var usage UsageRollup
+
+	for _, trace := range s.Traces {
+		observeTime(parseTimestamp(trace.Timestamp))
+
+		for _, score := range trace.Scores {
+			summary.ScoresByName[score.Name] = append(summary.ScoresByName[score.Name], score)
+		}
+
+		for i := range trace.Observations {
+			obs := &trace.Observations[i]
+			usage.add(obs)
+
+			observeTime(obs.StartTime)
+			if obs.EndTime != nil {
+				observeTime(*obs.EndTime)
+			}
+		}
+	}
+
+	summary.ObservationCount = usage.ObservationCount
+	summary.InputTokens = usage.InputTokens
+	summary.OutputTokens = usage.OutputTokens
+	summary.TotalTokens = usage.TotalTokens
+	summary.TotalCost = usage.TotalCost
+
+	if !summary.StartTime.IsZero() {
+		summary.Duration = summary.EndTime.Sub(summary.StartTime)
+	}
+
+	return summary
+}
+
+// parseTimestamp parses an API timestamp, returning the zero time if it is invalid
+func parseTimestamp(s string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// derefInt returns the value of p or 0 if p is nil
+func derefInt(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// derefFloat returns the value of p or 0 if p is nil
+func derefFloat(p *float64) float64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
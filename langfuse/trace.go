@@ -67,6 +67,11 @@ func (c *Client) CreateTrace(params TraceParams) (*Trace, error) {
 		params: params,
 	}
 
+	// Decide once per trace; the trace's events are filtered in enqueue
+	if c.sampling != nil {
+		c.sampling.decide(id, params)
+	}
+
 	// Create trace event
 	event := Event{
 		ID:        generateID(),
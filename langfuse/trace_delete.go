@@ -0,0 +1,162 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// DeleteTraceParams represents parameters for deleting a single trace
+type DeleteTraceParams struct {
+	TraceID string
+}
+
+// DeleteTracesParams represents parameters for deleting multiple traces
+type DeleteTracesParams struct {
+	TraceIDs []string `json:"traceIds"`
+}
+
+// DeleteUserTracesParams represents parameters for deleting all traces of a user
+type DeleteUserTracesParams struct {
+	// UserID is the user whose traces are deleted (required)
+	UserID string
+
+	// DryRun lists the matching traces without deleting them
+	DryRun bool
+
+	// BatchSize is the number of trace IDs sent per delete request (default: 100)
+	BatchSize int
+}
+
+// DeleteUserTracesResult lists the traces of a user and which of them were
+// deleted
+type DeleteUserTracesResult struct {
+	UserID string
+	DryRun bool
+
+	// Traces are all matched traces (in dry-run mode, those that would be deleted)
+	Traces []TraceWithDetails
+
+	// Deleted are the IDs of traces whose delete request succeeded
+	Deleted []string
+
+	// Failed are the IDs of traces whose delete request failed
+	Failed []string
+}
+
+// TraceIDs returns the IDs of the matched traces
+func (r *DeleteUserTracesResult) TraceIDs() []string {
+	ids := make([]string, len(r.Traces))
+	for i, trace := range r.Traces {
+		ids[i] = trace.ID
+	}
+	return ids
+}
+
+// DeleteTrace deletes a trace and its observations and scores
+func (c *Client) DeleteTrace(ctx context.Context, params DeleteTraceParams) error {
+	if !c.config.Enabled {
+		return fmt.Errorf("client is disabled")
+	}
+
+	if params.TraceID == "" {
+		return fmt.Errorf("traceID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/traces/%s", c.config.BaseURL, url.PathEscape(params.TraceID))
+
+	if _, err := c.sendJSON(ctx, http.MethodDelete, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete trace: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTraces deletes multiple traces in a single request
+func (c *Client) DeleteTraces(ctx context.Context, params DeleteTracesParams) error {
+	if !c.config.Enabled {
+		return fmt.Errorf("client is disabled")
+	}
+
+	if len(params.TraceIDs) == 0 {
+		return fmt.Errorf("at least one traceID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/api/public/traces", c.config.BaseURL)
+
+	if _, err := c.sendJSON(ctx, http.MethodDelete, endpoint, params, nil); err != nil {
+		return fmt.Errorf("failed to delete traces: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteUserTraces finds all traces of a user and deletes them, e.g. to honour
+// an erasure request. With DryRun set, the matching traces are returned but
+// nothing is deleted. If some delete requests fail, the remaining batches are
+// still sent and the result is returned with an error; Failed lists the
+// traces that were not deleted.
+func (c *Client) DeleteUserTraces(ctx context.Context, params DeleteUserTracesParams) (*DeleteUserTracesResult, error) {
+	if !c.config.Enabled {
+		return nil, fmt.Errorf("client is disabled")
+	}
+
+	if params.UserID == "" {
+		return nil, fmt.Errorf("userID is required")
+	}
+
+	batchSize := params.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	result := &DeleteUserTracesResult{
+		UserID: params.UserID,
+		DryRun: params.DryRun,
+	}
+
+	// Collect all IDs before deleting so pagination is not shifted by deletes
+	listParams := ListTracesParams{
+		UserID:  Ptr(params.UserID),
+		Limit:   Ptr(batchSize),
+		OrderBy: Ptr("timestamp.asc"),
+		Fields:  []TraceField{TraceFieldCore},
+	}
+	for trace, err := range c.IterTraces(ctx, listParams, IterOptions{}) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list traces for user %s: %w", params.UserID, err)
+		}
+		result.Traces = append(result.Traces, trace)
+	}
+
+	if params.DryRun {
+		for _, trace := range result.Traces {
+			c.logger.Debug("dry run: would delete trace",
+				slog.String("trace_id", trace.ID),
+				slog.Time("timestamp", trace.Timestamp),
+			)
+		}
+		return result, nil
+	}
+
+	var firstErr error
+	ids := result.TraceIDs()
+	for start := 0; start < len(ids); start += batchSize {
+		batch := ids[start:min(start+batchSize, len(ids))]
+		if err := c.DeleteTraces(ctx, DeleteTracesParams{TraceIDs: batch}); err != nil {
+			result.Failed = append(result.Failed, batch...)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		result.Deleted = append(result.Deleted, batch...)
+	}
+
+	if firstErr != nil {
+		return result, fmt.Errorf("failed to delete %d of %d traces for user %s: %w", len(result.Failed), len(ids), params.UserID, firstErr)
+	}
+	return result, nil
+}
@@ -0,0 +1,259 @@
+package langfuse
+
+import (
+	"iter"
+	"sort"
+	"time"
+)
+
+// ObservationNode is an observation together with its position in a TraceTree
+type ObservationNode struct {
+	Observation *ObservationDetails
+	Parent      *ObservationNode
+	Children    []*ObservationNode
+
+	// Depth is 0 for root observations
+	Depth int
+}
+
+// UsageRollup aggregates token usage and cost over a set of observations
+type UsageRollup struct {
+	ObservationCount int
+	InputTokens      int
+	OutputTokens     int
+	TotalTokens      int
+	TotalCost        float64
+}
+
+// add accumulates the usage of a single observation, preferring Usage and
+// falling back to UsageDetails/CostDetails
+func (r *UsageRollup) add(obs *ObservationDetails) {
+	r.ObservationCount++
+
+	switch {
+	case obs.Usage != nil:
+		input, output := derefInt(obs.Usage.Input), derefInt(obs.Usage.Output)
+		r.InputTokens += input
+		r.OutputTokens += output
+		if obs.Usage.Total != nil {
+			r.TotalTokens += *obs.Usage.Total
+		} else {
+			r.TotalTokens += input + output
+		}
+	case obs.UsageDetails != nil:
+		r.InputTokens += obs.UsageDetails["input"]
+		r.OutputTokens += obs.UsageDetails["output"]
+		r.TotalTokens += obs.UsageDetails["total"]
+	}
+
+	switch {
+	case obs.Usage != nil && obs.Usage.TotalCost != nil:
+		r.TotalCost += *obs.Usage.TotalCost
+	case obs.Usage != nil && (obs.Usage.InputCost != nil || obs.Usage.OutputCost != nil):
+		r.TotalCost += derefFloat(obs.Usage.InputCost) + derefFloat(obs.Usage.OutputCost)
+	case obs.CostDetails != nil:
+		r.TotalCost += obs.CostDetails["total"]
+	}
+}
+
+// Rollup returns the usage and cost of this observation and all its descendants
+func (n *ObservationNode) Rollup() UsageRollup {
+	var rollup UsageRollup
+	for node := range n.Walk() {
+		rollup.add(node.Observation)
+	}
+	return rollup
+}
+
+// Walk returns a depth-first, pre-order iterator over this node and its descendants
+func (n *ObservationNode) Walk() iter.Seq[*ObservationNode] {
+	return func(yield func(*ObservationNode) bool) {
+		n.walk(yield)
+	}
+}
+
+// walk visits the subtree and reports whether iteration should continue
+func (n *ObservationNode) walk(yield func(*ObservationNode) bool) bool {
+	if !yield(n) {
+		return false
+	}
+	for _, child := range n.Children {
+		if !child.walk(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// endTime returns the end of the observation, or its start for point-in-time
+// observations such as events
+func (n *ObservationNode) endTime() time.Time {
+	if n.Observation.EndTime != nil {
+		return *n.Observation.EndTime
+	}
+	return n.Observation.StartTime
+}
+
+// TraceTree is the observation hierarchy of a fetched trace
+type TraceTree struct {
+	Trace *TraceWithFullDetails
+
+	// Roots are observations without a parent, or whose parent is not part of the trace
+	Roots []*ObservationNode
+
+	nodes map[string]*ObservationNode
+}
+
+// Tree builds the observation hierarchy of the trace
+func (t *TraceWithFullDetails) Tree() *TraceTree {
+	return NewTraceTree(t)
+}
+
+// NewTraceTree builds the observation hierarchy of a trace from its flat
+// Observations slice. Children are ordered by start time.
+func NewTraceTree(trace *TraceWithFullDetails) *TraceTree {
+	tree := &TraceTree{
+		Trace: trace,
+		nodes: make(map[string]*ObservationNode, len(trace.Observations)),
+	}
+
+	for i := range trace.Observations {
+		obs := &trace.Observations[i]
+		tree.nodes[obs.ID] = &ObservationNode{Observation: obs}
+	}
+
+	for i := range trace.Observations {
+		obs := &trace.Observations[i]
+		node := tree.nodes[obs.ID]
+
+		if obs.ParentObservationID != nil {
+			if parent, ok := tree.nodes[*obs.ParentObservationID]; ok && parent != node {
+				node.Parent = parent
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		tree.Roots = append(tree.Roots, node)
+	}
+
+	sortNodes(tree.Roots)
+	for _, node := range tree.nodes {
+		sortNodes(node.Children)
+	}
+
+	for _, root := range tree.Roots {
+		setDepth(root, 0)
+	}
+
+	return tree
+}
+
+// sortNodes orders nodes by start time
+func sortNodes(nodes []*ObservationNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Observation.StartTime.Before(nodes[j].Observation.StartTime)
+	})
+}
+
+// setDepth assigns depths to a subtree
+func setDepth(node *ObservationNode, depth int) {
+	node.Depth = depth
+	for _, child := range node.Children {
+		setDepth(child, depth+1)
+	}
+}
+
+// Node returns the node of the observation with the given ID
+func (t *TraceTree) Node(observationID string) (*ObservationNode, bool) {
+	node, ok := t.nodes[observationID]
+	return node, ok
+}
+
+// Children returns the direct children of the observation with the given ID
+func (t *TraceTree) Children(observationID string) []*ObservationNode {
+	node, ok := t.nodes[observationID]
+	if !ok {
+		return nil
+	}
+	return node.Children
+}
+
+// Walk returns a depth-first, pre-order iterator over all observations of the trace
+func (t *TraceTree) Walk() iter.Seq[*ObservationNode] {
+	return func(yield func(*ObservationNode) bool) {
+		for _, root := range t.Roots {
+			if !root.walk(yield) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns the observations of the given types in depth-first order
+func (t *TraceTree) Filter(types ...ObservationType) []*ObservationNode {
+	var nodes []*ObservationNode
+	for node := range t.Walk() {
+		for _, typ := range types {
+			if node.Observation.Type == typ {
+				nodes = append(nodes, node)
+				break
+			}
+		}
+	}
+	return nodes
+}
+
+// Rollup returns the usage and cost of all observations of the trace
+func (t *TraceTree) Rollup() UsageRollup {
+	var rollup UsageRollup
+	for node := range t.Walk() {
+		rollup.add(node.Observation)
+	}
+	return rollup
+}
+
+// CriticalPath returns the chain of observations that determines the trace's
+// end-to-end latency: starting at the root that ends last, it follows the
+// child that ends last at every level.
+func (t *TraceTree) CriticalPath() []*ObservationNode {
+	var path []*ObservationNode
+
+	candidates := t.Roots
+	for len(candidates) > 0 {
+		latest := candidates[0]
+		for _, node := range candidates[1:] {
+			if node.endTime().After(latest.endTime()) {
+				latest = node
+			}
+		}
+		path = append(path, latest)
+		candidates = latest.Children
+	}
+
+	return path
+}
+
+// CriticalPathLatency returns the time from the earliest root start to the end
+// of the critical path
+func (t *TraceTree) CriticalPathLatency() time.Duration {
+	path := t.CriticalPath()
+	if len(path) == 0 {
+		return 0
+	}
+
+	start := path[0].Observation.StartTime
+	for _, root := range t.Roots {
+		if root.Observation.StartTime.Before(start) {
+			start = root.Observation.StartTime
+		}
+	}
+
+	end := path[0].endTime()
+	for _, node := range path[1:] {
+		if node.endTime().After(end) {
+			end = node.endTime()
+		}
+	}
+
+	return end.Sub(start)
+}
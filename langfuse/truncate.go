@@ -0,0 +1,246 @@
+package langfuse
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// truncatedMetadataKey is the metadata key that records the original JSON size
+// in bytes of each truncated field
+const truncatedMetadataKey = "truncatedOriginalSize"
+
+// TruncationLimits caps the size of a single field. Zero values mean unlimited.
+type TruncationLimits struct {
+	// MaxStringLength is the maximum length in bytes of a string value
+	MaxStringLength int
+
+	// MaxDepth is the maximum nesting depth of maps and slices
+	MaxDepth int
+
+	// MaxItems is the maximum number of entries in a map or slice
+	MaxItems int
+
+	// MaxFieldSize is the maximum JSON size in bytes of the whole field; larger
+	// fields are replaced by a cut-off JSON string
+	MaxFieldSize int
+}
+
+// TruncationConfig sets size limits for Input, Output and Metadata
+type TruncationConfig struct {
+	Input    TruncationLimits
+	Output   TruncationLimits
+	Metadata TruncationLimits
+}
+
+// DefaultTruncationLimits returns limits that keep single events well below
+// the ingestion payload limit
+func DefaultTruncationLimits() TruncationLimits {
+	return TruncationLimits{
+		MaxStringLength: 32 * 1024,
+		MaxDepth:        20,
+		MaxItems:        1000,
+		MaxFieldSize:    1024 * 1024,
+	}
+}
+
+// DefaultTruncationConfig returns DefaultTruncationLimits for every field
+func DefaultTruncationConfig() *TruncationConfig {
+	limits := DefaultTruncationLimits()
+	return &TruncationConfig{Input: limits, Output: limits, Metadata: limits}
+}
+
+// truncateEvent applies the truncation limits to the input, output and
+// metadata of an event and records the original size of truncated fields in
+// the metadata. It runs after masking and media upload so that both see the
+// complete values; media references and data URIs are never cut.
+func truncateEvent(cfg *TruncationConfig, event *Event) {
+	sizes := make(map[string]int)
+	var truncated map[string]interface{}
+	for field, limits := range map[string]TruncationLimits{
+		"input":    cfg.Input,
+		"output":   cfg.Output,
+		"metadata": cfg.Metadata,
+	} {
+		value, ok := event.Body[field]
+		if !ok || limits == (TruncationLimits{}) {
+			continue
+		}
+
+		t := truncator{limits: limits}
+		result, changed := t.truncate(value, 0)
+		if limits.MaxFieldSize > 0 {
+			result, changed = t.capFieldSize(result, changed)
+		}
+		if !changed {
+			continue
+		}
+
+		if truncated == nil {
+			truncated = make(map[string]interface{})
+		}
+		truncated[field] = result
+		if encoded, err := json.Marshal(value); err == nil {
+			sizes[field] = len(encoded)
+		}
+	}
+
+	if len(truncated) == 0 {
+		return
+	}
+
+	// Copy the body so shared maps from caller params are not modified
+	body := make(map[string]interface{}, len(event.Body)+1)
+	for k, v := range event.Body {
+		body[k] = v
+	}
+	for field, value := range truncated {
+		body[field] = value
+	}
+
+	metadata := make(map[string]interface{})
+	switch m := body["metadata"].(type) {
+	case map[string]interface{}:
+		for k, v := range m {
+			metadata[k] = v
+		}
+	case nil:
+	default:
+		metadata["value"] = m
+	}
+	metadata[truncatedMetadataKey] = sizes
+	body["metadata"] = metadata
+
+	event.Body = body
+}
+
+// truncator applies one set of limits to a value
+type truncator struct {
+	limits TruncationLimits
+}
+
+// truncate returns value within the string, depth and item limits, copying
+// maps and slices rather than modifying them in place
+func (t truncator) truncate(value interface{}, depth int) (interface{}, bool) {
+	switch v := value.(type) {
+	case nil, bool, float64, float32, int, int64, int32, []byte, *Media, Media:
+		return value, false
+	case string:
+		return t.truncateString(v)
+	case map[string]interface{}:
+		if t.limits.MaxDepth > 0 && depth >= t.limits.MaxDepth {
+			return fmt.Sprintf("[truncated: map with %d keys exceeds max depth]", len(v)), true
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+
+		dropped := 0
+		if t.limits.MaxItems > 0 && len(keys) > t.limits.MaxItems {
+			sort.Strings(keys)
+			dropped = len(keys) - t.limits.MaxItems
+			keys = keys[:t.limits.MaxItems]
+		}
+
+		out := make(map[string]interface{}, len(keys)+1)
+		changed := dropped > 0
+		for _, k := range keys {
+			item, itemChanged := t.truncate(v[k], depth+1)
+			out[k] = item
+			changed = changed || itemChanged
+		}
+		if !changed {
+			return value, false
+		}
+		if dropped > 0 {
+			out["_truncated"] = fmt.Sprintf("%d more keys", dropped)
+		}
+		return out, true
+	case []interface{}:
+		if t.limits.MaxDepth > 0 && depth >= t.limits.MaxDepth {
+			return fmt.Sprintf("[truncated: list with %d items exceeds max depth]", len(v)), true
+		}
+
+		items := v
+		dropped := 0
+		if t.limits.MaxItems > 0 && len(items) > t.limits.MaxItems {
+			dropped = len(items) - t.limits.MaxItems
+			items = items[:t.limits.MaxItems]
+		}
+
+		out := make([]interface{}, 0, len(items)+1)
+		changed := dropped > 0
+		for _, item := range items {
+			truncated, itemChanged := t.truncate(item, depth+1)
+			out = append(out, truncated)
+			changed = changed || itemChanged
+		}
+		if !changed {
+			return value, false
+		}
+		if dropped > 0 {
+			out = append(out, fmt.Sprintf("[truncated: %d more items]", dropped))
+		}
+		return out, true
+	default:
+		// Other types (structs, typed slices and maps) are truncated through
+		// their JSON form
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return value, false
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			return value, false
+		}
+		truncated, changed := t.truncate(decoded, depth)
+		if !changed {
+			return value, false
+		}
+		return truncated, true
+	}
+}
+
+// truncateString cuts a string to MaxStringLength bytes on a rune boundary.
+// Media references are kept whole, and data URIs that were not uploaded are
+// replaced, since a cut-off data URI cannot be decoded.
+func (t truncator) truncateString(s string) (interface{}, bool) {
+	if t.limits.MaxStringLength <= 0 || len(s) <= t.limits.MaxStringLength {
+		return s, false
+	}
+	if strings.HasPrefix(s, mediaReferencePrefix) {
+		return s, false
+	}
+	if matches := dataURIPattern.FindStringSubmatch(s); matches != nil {
+		return fmt.Sprintf("[truncated: %s data URI of %d bytes]", matches[1], len(s)), true
+	}
+	return cutString(s, t.limits.MaxStringLength) + fmt.Sprintf("...[truncated %d bytes]", len(s)-t.limits.MaxStringLength), true
+}
+
+// capFieldSize replaces a value whose JSON form exceeds MaxFieldSize with a
+// cut-off JSON string
+func (t truncator) capFieldSize(value interface{}, changed bool) (interface{}, bool) {
+	encoded, err := json.Marshal(value)
+	if err != nil || len(encoded) <= t.limits.MaxFieldSize {
+		return value, changed
+	}
+	if s, ok := value.(string); ok {
+		return cutString(s, t.limits.MaxFieldSize) + fmt.Sprintf("...[truncated %d bytes]", len(s)-t.limits.MaxFieldSize), true
+	}
+	return cutString(string(encoded), t.limits.MaxFieldSize) + fmt.Sprintf("...[truncated %d bytes]", len(encoded)-t.limits.MaxFieldSize), true
+}
+
+// cutString returns at most n bytes of s without splitting a UTF-8 sequence
+func cutString(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
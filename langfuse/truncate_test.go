@@ -0,0 +1,239 @@
+package langfuse
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTruncatorTruncate(t *testing.T) {
+	long := strings.Repeat("a", 20)
+
+	tests := []struct {
+		name        string
+		limits      TruncationLimits
+		value       interface{}
+		want        interface{}
+		wantChanged bool
+	}{
+		{
+			name:   "short string",
+			limits: TruncationLimits{MaxStringLength: 10},
+			value:  "short",
+			want:   "short",
+		},
+		{
+			name:        "long string",
+			limits:      TruncationLimits{MaxStringLength: 10},
+			value:       long,
+			want:        "aaaaaaaaaa...[truncated 10 bytes]",
+			wantChanged: true,
+		},
+		{
+			name:        "multi-byte string is cut on a rune boundary",
+			limits:      TruncationLimits{MaxStringLength: 2},
+			value:       "héllo",
+			want:        "h...[truncated 4 bytes]",
+			wantChanged: true,
+		},
+		{
+			name:   "media reference is kept",
+			limits: TruncationLimits{MaxStringLength: 10},
+			value:  "@@@langfuseMedia:type=image/png|id=abc|source=bytes@@@",
+			want:   "@@@langfuseMedia:type=image/png|id=abc|source=bytes@@@",
+		},
+		{
+			name:        "data URI is replaced",
+			limits:      TruncationLimits{MaxStringLength: 10},
+			value:       "data:image/png;base64,iVBORw0KGgoAAAANSUhEUg==",
+			want:        "[truncated: image/png data URI of 46 bytes]",
+			wantChanged: true,
+		},
+		{
+			name:        "nested string",
+			limits:      TruncationLimits{MaxStringLength: 10},
+			value:       map[string]interface{}{"messages": []interface{}{long}},
+			want:        map[string]interface{}{"messages": []interface{}{"aaaaaaaaaa...[truncated 10 bytes]"}},
+			wantChanged: true,
+		},
+		{
+			name:        "max depth",
+			limits:      TruncationLimits{MaxDepth: 1},
+			value:       map[string]interface{}{"a": map[string]interface{}{"b": 1.0}},
+			want:        map[string]interface{}{"a": "[truncated: map with 1 keys exceeds max depth]"},
+			wantChanged: true,
+		},
+		{
+			name:        "max items in a list",
+			limits:      TruncationLimits{MaxItems: 2},
+			value:       []interface{}{1.0, 2.0, 3.0},
+			want:        []interface{}{1.0, 2.0, "[truncated: 1 more items]"},
+			wantChanged: true,
+		},
+		{
+			name:        "max items in a map",
+			limits:      TruncationLimits{MaxItems: 1},
+			value:       map[string]interface{}{"b": 2.0, "a": 1.0},
+			want:        map[string]interface{}{"a": 1.0, "_truncated": "1 more keys"},
+			wantChanged: true,
+		},
+		{
+			name:        "struct through its JSON form",
+			limits:      TruncationLimits{MaxStringLength: 10},
+			value:       struct{ Text string }{Text: long},
+			want:        map[string]interface{}{"Text": "aaaaaaaaaa...[truncated 10 bytes]"},
+			wantChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := truncator{limits: tt.limits}.truncate(tt.value, 0)
+			if changed != tt.wantChanged {
+				t.Errorf("changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if !jsonEqual(t, got, tt.want) {
+				t.Errorf("truncate() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncatorCapFieldSize(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       interface{}
+		want        interface{}
+		wantChanged bool
+	}{
+		{
+			name:  "small value",
+			value: map[string]interface{}{"a": "b"},
+			want:  map[string]interface{}{"a": "b"},
+		},
+		{
+			name:        "large map",
+			value:       map[string]interface{}{"text": strings.Repeat("x", 20)},
+			want:        `{"text":"xxxxxxx...[truncated 15 bytes]`,
+			wantChanged: true,
+		},
+		{
+			name:        "large string",
+			value:       strings.Repeat("x", 30),
+			want:        strings.Repeat("x", 16) + "...[truncated 14 bytes]",
+			wantChanged: true,
+		},
+		{
+			name:        "value with a media reference",
+			value:       map[string]interface{}{"image": "@@@langfuseMedia:type=image/png|id=abc|source=bytes@@@"},
+			want:        `{"image":"@@@lan...[truncated 50 bytes]`,
+			wantChanged: true,
+		},
+		{
+			name:        "value with a data URI",
+			value:       map[string]interface{}{"image": "data:image/png;base64,iVBORw0KGgoAAAANSUhEUg=="},
+			want:        `{"image":"data:i...[truncated 42 bytes]`,
+			wantChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := truncator{limits: TruncationLimits{MaxFieldSize: 16}}.capFieldSize(tt.value, false)
+			if changed != tt.wantChanged {
+				t.Errorf("changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if !jsonEqual(t, got, tt.want) {
+				t.Errorf("capFieldSize() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateEventRecordsOriginalSize(t *testing.T) {
+	input := map[string]interface{}{"text": strings.Repeat("x", 100)}
+	event := Event{Type: EventTypeTraceCreate, Body: map[string]interface{}{
+		"id":    "trace",
+		"input": input,
+	}}
+
+	truncateEvent(&TruncationConfig{Input: TruncationLimits{MaxStringLength: 10}}, &event)
+
+	metadata, ok := event.Body["metadata"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("metadata = %#v, want a map", event.Body["metadata"])
+	}
+	sizes, _ := metadata[truncatedMetadataKey].(map[string]int)
+	if sizes["input"] != 111 {
+		t.Errorf("original input size = %d, want 111", sizes["input"])
+	}
+	if len(input["text"].(string)) != 100 {
+		t.Error("caller's input map was modified")
+	}
+}
+
+func TestTruncationRunsAfterMaskingAndMedia(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, func(c *Config) {
+		c.FlushAt = 100
+		c.FlushInterval = time.Hour
+		c.Mask = MaskPaths("metadata.user.ssn")
+		c.Truncation = &TruncationConfig{
+			Input:    TruncationLimits{MaxFieldSize: 100},
+			Metadata: TruncationLimits{MaxFieldSize: 100},
+		}
+	})
+
+	dataURI := "data:image/png;base64," + base64.StdEncoding.EncodeToString(make([]byte, 200))
+	if _, err := client.CreateTrace(TraceParams{
+		Input: map[string]interface{}{"image": dataURI},
+		Metadata: map[string]interface{}{
+			"user":    map[string]interface{}{"ssn": "123-45-6789"},
+			"padding": strings.Repeat("p", 200),
+		},
+	}); err != nil {
+		t.Fatalf("CreateTrace: %v", err)
+	}
+
+	client.batcher.mu.Lock()
+	events := append([]Event(nil), client.batcher.queue...)
+	client.batcher.mu.Unlock()
+	if len(events) != 1 {
+		t.Fatalf("queued %d events, want 1", len(events))
+	}
+
+	encoded, err := json.Marshal(events[0].Body)
+	if err != nil {
+		t.Fatalf("marshal body: %v", err)
+	}
+	if strings.Contains(string(encoded), "123-45-6789") {
+		t.Errorf("masked value was sent: %s", encoded)
+	}
+	if strings.Contains(string(encoded), dataURI) {
+		t.Errorf("data URI exceeding MaxFieldSize was sent: %s", encoded)
+	}
+	if input, _ := json.Marshal(events[0].Body["input"]); len(input) > 150 {
+		t.Errorf("input is %d bytes, want it capped near MaxFieldSize: %s", len(input), input)
+	}
+}
+
+// jsonEqual reports whether a and b have the same JSON form
+func jsonEqual(t *testing.T, a, b interface{}) bool {
+	t.Helper()
+
+	encodedA, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("marshal %#v: %v", a, err)
+	}
+	encodedB, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("marshal %#v: %v", b, err)
+	}
+	return string(encodedA) == string(encodedB)
+}
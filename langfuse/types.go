@@ -33,6 +33,22 @@ const (
 	LevelError   ObservationLevel = "ERROR"
 )
 
+// ObservationType represents the type of an observation retrieved from API
+type ObservationType string
+
+const (
+	ObservationTypeSpan       ObservationType = "SPAN"
+	ObservationTypeGeneration ObservationType = "GENERATION"
+	ObservationTypeEvent      ObservationType = "EVENT"
+	ObservationTypeAgent      ObservationType = "AGENT"
+	ObservationTypeTool       ObservationType = "TOOL"
+	ObservationTypeChain      ObservationType = "CHAIN"
+	ObservationTypeRetriever  ObservationType = "RETRIEVER"
+	ObservationTypeEvaluator  ObservationType = "EVALUATOR"
+	ObservationTypeEmbedding  ObservationType = "EMBEDDING"
+	ObservationTypeGuardrail  ObservationType = "GUARDRAIL"
+)
+
 // Event represents a single event in the ingestion batch
 type Event struct {
 	ID        string                 `json:"id"`
@@ -79,3 +95,34 @@ type Usage struct {
 	OutputCost *float64 `json:"outputCost,omitempty"`
 	TotalCost  *float64 `json:"totalCost,omitempty"`
 }
+
+// UsageDetails maps usage types (e.g. "input", "output", "input_cached_tokens")
+// to token or unit counts
+type UsageDetails map[string]int
+
+// Add returns the sum of both usage details
+func (d UsageDetails) Add(other UsageDetails) UsageDetails {
+	sum := make(UsageDetails, len(d))
+	for k, v := range d {
+		sum[k] = v
+	}
+	for k, v := range other {
+		sum[k] += v
+	}
+	return sum
+}
+
+// CostDetails maps usage types to their cost in USD
+type CostDetails map[string]float64
+
+// Add returns the sum of both cost details
+func (d CostDetails) Add(other CostDetails) CostDetails {
+	sum := make(CostDetails, len(d))
+	for k, v := range d {
+		sum[k] = v
+	}
+	for k, v := range other {
+		sum[k] += v
+	}
+	return sum
+}